@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = CertificateCoversHostname{}
+
+// CertificateCoversHostname is the underlying struct implementing CertificateCoversHostname.
+type CertificateCoversHostname struct {
+	PathExpression path.Expression
+}
+
+type CertificateCoversHostnameRequest struct {
+	Config         tfsdk.Config
+	ConfigValue    types.String
+	Path           path.Path
+	PathExpression path.Expression
+}
+
+type CertificateCoversHostnameResponse struct {
+	Diagnostics diag.Diagnostics
+}
+
+func (av CertificateCoversHostname) Description(_ context.Context) string {
+	return fmt.Sprintf("The subject alternative names of the certificate of the %s attribute must cover this hostname", av.PathExpression)
+}
+
+func (av CertificateCoversHostname) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("The subject alternative names of the certificate of the [`%s`](#%s) attribute must cover this hostname", av.PathExpression, av.PathExpression)
+}
+
+func (av CertificateCoversHostname) Validate(ctx context.Context, req CertificateCoversHostnameRequest, res *CertificateCoversHostnameResponse) {
+	// If attribute configuration is null or unknown, there is nothing else to validate
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	paths, diags := req.Config.PathMatches(ctx, req.PathExpression.Merge(av.PathExpression))
+	res.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if len(paths) == 0 {
+		res.Diagnostics.AddError(
+			fmt.Sprintf("Invalid configuration for attribute %s", req.Path),
+			"Path must be set",
+		)
+		return
+	}
+
+	hostname := req.ConfigValue.ValueString()
+
+	for _, path := range paths {
+		var mpVal attr.Value
+		diags = req.Config.GetAttribute(ctx, path, &mpVal)
+		if diags.HasError() {
+			res.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", req.Path),
+				fmt.Sprintf("Unable to retrieve attribute path: %q", path),
+			)
+			return
+		}
+
+		// If the certificate is null or unknown, it cannot be checked yet
+		if mpVal.IsNull() || mpVal.IsUnknown() {
+			continue
+		}
+
+		certificate, ok := mpVal.(types.String)
+		if !ok {
+			res.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", req.Path),
+				fmt.Sprintf("The attribute %s is not a string", path),
+			)
+			return
+		}
+
+		// A malformed certificate is reported by the validators of its own attribute
+		certificates, err := ParsePEMCertificates(certificate.ValueString())
+		if err != nil {
+			continue
+		}
+
+		// VerifyHostname matches the DNS names (with wildcards) and the IP addresses of the SANs
+		if err := certificates[0].VerifyHostname(hostname); err != nil {
+			names := append([]string{}, certificates[0].DNSNames...)
+			for _, ip := range certificates[0].IPAddresses {
+				names = append(names, ip.String())
+			}
+			if len(names) == 0 {
+				names = append(names, "none")
+			}
+
+			res.Diagnostics.AddAttributeError(
+				req.Path,
+				fmt.Sprintf("Invalid configuration for attribute %s", req.Path),
+				fmt.Sprintf("The hostname %q is not covered by the certificate of the %s attribute (subject alternative names: %s)", hostname, path, strings.Join(names, ", ")),
+			)
+		}
+	}
+}
+
+func (av CertificateCoversHostname) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateReq := CertificateCoversHostnameRequest{
+		Config:         req.Config,
+		ConfigValue:    req.ConfigValue,
+		Path:           req.Path,
+		PathExpression: req.PathExpression,
+	}
+	validateResp := &CertificateCoversHostnameResponse{}
+
+	av.Validate(ctx, validateReq, validateResp)
+
+	resp.Diagnostics.Append(validateResp.Diagnostics...)
+}
@@ -0,0 +1,183 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+func testCertificateWithSANs(t *testing.T, dnsNames []string, ips []net.IP) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCertificateCoversHostnameValidator(t *testing.T) {
+	t.Parallel()
+
+	certificate := testCertificateWithSANs(t, []string{"example.com", "*.apps.example.com"}, []net.IP{net.ParseIP("192.0.2.10")})
+	certificateWithoutSAN := testCertificateWithSANs(t, nil, nil)
+
+	config := func(certificate tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"certificate": schema.StringAttribute{},
+					"hostname":    schema.StringAttribute{},
+				},
+			},
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"certificate": tftypes.String,
+					"hostname":    tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"certificate": certificate,
+				"hostname":    tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	type testCase struct {
+		hostname    types.String
+		certificate tftypes.Value
+		in          path.Expression
+		expError    bool
+	}
+
+	testCases := map[string]testCase{
+		"exact": {
+			hostname:    types.StringValue("example.com"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+		},
+		"wildcard": {
+			hostname:    types.StringValue("web.apps.example.com"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+		},
+		"ip-address": {
+			hostname:    types.StringValue("192.0.2.10"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+		},
+		"hostname-null": {
+			hostname:    types.StringNull(),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+		},
+		"hostname-unknown": {
+			hostname:    types.StringUnknown(),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+		},
+		"certificate-null": {
+			hostname:    types.StringValue("www.example.org"),
+			certificate: tftypes.NewValue(tftypes.String, nil),
+			in:          path.MatchRoot("certificate"),
+		},
+		"certificate-unknown": {
+			hostname:    types.StringValue("www.example.org"),
+			certificate: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			in:          path.MatchRoot("certificate"),
+		},
+		"certificate-malformed": {
+			hostname:    types.StringValue("www.example.org"),
+			certificate: tftypes.NewValue(tftypes.String, "not a certificate"),
+			in:          path.MatchRoot("certificate"),
+		},
+		"not-covered": {
+			hostname:    types.StringValue("www.example.org"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+			expError:    true,
+		},
+		"wildcard-single-label": {
+			hostname:    types.StringValue("a.web.apps.example.com"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("certificate"),
+			expError:    true,
+		},
+		"common-name-ignored": {
+			hostname:    types.StringValue("www.example.com"),
+			certificate: tftypes.NewValue(tftypes.String, certificateWithoutSAN),
+			in:          path.MatchRoot("certificate"),
+			expError:    true,
+		},
+		"path-not-found": {
+			hostname:    types.StringValue("example.com"),
+			certificate: tftypes.NewValue(tftypes.String, certificate),
+			in:          path.MatchRoot("unknown"),
+			expError:    true,
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			req := internal.CertificateCoversHostnameRequest{
+				Config:         config(test.certificate),
+				ConfigValue:    test.hostname,
+				Path:           path.Root("hostname"),
+				PathExpression: path.MatchRoot("hostname"),
+			}
+			res := &internal.CertificateCoversHostnameResponse{}
+
+			internal.CertificateCoversHostname{
+				PathExpression: test.in,
+			}.Validate(context.TODO(), req, res)
+
+			if test.expError && !res.Diagnostics.HasError() {
+				t.Fatal("expected error(s), got none")
+			}
+
+			if !test.expError && res.Diagnostics.HasError() {
+				t.Fatalf("unexpected error(s): %s", res.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps the letters of the other scripts that look like an ASCII letter to this letter.
+// It is a subset of the Unicode confusables (UTS #39) limited to the ASCII letters.
+var confusables = map[rune]rune{
+	// Cyrillic.
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ј': 'j',
+	'ԁ': 'd', 'һ': 'h', 'ԛ': 'q', 'ԝ': 'w', 'ӏ': 'l',
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P', 'С': 'C', 'Т': 'T',
+	'Х': 'X', 'У': 'Y', 'Ү': 'Y', 'Ѕ': 'S', 'І': 'I', 'Ј': 'J', 'Ԝ': 'W',
+	// Greek.
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M', 'Ν': 'N', 'Ο': 'O',
+	'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	'α': 'a', 'ι': 'i', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'υ': 'u', 'ϲ': 'c', 'ϳ': 'j',
+	// Armenian.
+	'հ': 'h', 'ո': 'n', 'ս': 'u', 'օ': 'o', 'զ': 'q', 'Ս': 'U', 'Օ': 'O',
+	// Latin.
+	'ı': 'i', 'ɑ': 'a', 'ɡ': 'g', 'ɩ': 'i', 'ʏ': 'y',
+}
+
+// Confusable returns the ASCII character that the rune looks like.
+// The rune is a homoglyph if it is not ASCII and if it is a compatibility variant of
+// an ASCII letter or digit (Ex: the fullwidth Ａ, the mathematical 𝐀), or a letter that
+// looks like an ASCII letter (Ex: the Cyrillic а) in a value mixing it with Latin letters.
+// The mixedWithLatin flag tells if the value contains Latin letters, so the words written
+// only in another script (Ex: a Greek word) are not reported.
+func Confusable(r rune, mixedWithLatin bool) (rune, bool) {
+	if r <= unicode.MaxASCII {
+		return 0, false
+	}
+
+	if ascii, ok := confusables[r]; ok && (mixedWithLatin || unicode.Is(unicode.Latin, r)) {
+		return ascii, true
+	}
+
+	if decomposed := []rune(norm.NFKC.String(string(r))); len(decomposed) == 1 && decomposed[0] <= unicode.MaxASCII &&
+		(unicode.IsLetter(decomposed[0]) || unicode.IsDigit(decomposed[0])) {
+		return decomposed[0], true
+	}
+
+	return 0, false
+}
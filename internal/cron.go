@@ -0,0 +1,450 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron dialects.
+const (
+	// CronDialectStandard is the POSIX syntax with 5 fields: minute hour day-of-month month day-of-week.
+	CronDialectStandard = ""
+	// CronDialectSeconds is the standard syntax with a leading second field (6 fields).
+	CronDialectSeconds = "seconds"
+	// CronDialectQuartz is the Quartz syntax: second minute hour day-of-month month day-of-week [year],
+	// with the ? L W and # special characters.
+	CronDialectQuartz = "quartz"
+)
+
+// CronMacros maps the supported macros to their standard expression.
+var CronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronFieldSpec struct {
+	name     string
+	min, max int
+	names    []string // names[i] is the name of the value min+i
+}
+
+var (
+	cronMonthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	cronWeekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+	cronSecond     = cronFieldSpec{name: "second", min: 0, max: 59}
+	cronMinute     = cronFieldSpec{name: "minute", min: 0, max: 59}
+	cronHour       = cronFieldSpec{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronFieldSpec{name: "day-of-month", min: 1, max: 31}
+	cronMonth      = cronFieldSpec{name: "month", min: 1, max: 12, names: cronMonthNames}
+	// The standard day-of-week is 0-7 (0 and 7 are Sunday), the Quartz day-of-week is 1-7 (1 is Sunday).
+	cronDayOfWeek       = cronFieldSpec{name: "day-of-week", min: 0, max: 7, names: cronWeekdayNames}
+	cronQuartzDayOfWeek = cronFieldSpec{name: "day-of-week", min: 1, max: 7, names: cronWeekdayNames}
+	cronQuartzYear      = cronFieldSpec{name: "year", min: 1970, max: 2099}
+)
+
+// CronFields returns the names of the fields of the dialect.
+func CronFields(dialect string) []string {
+	switch dialect {
+	case CronDialectSeconds:
+		return []string{"second", "minute", "hour", "day-of-month", "month", "day-of-week"}
+	case CronDialectQuartz:
+		return []string{"second", "minute", "hour", "day-of-month", "month", "day-of-week", "[year]"}
+	default:
+		return []string{"minute", "hour", "day-of-month", "month", "day-of-week"}
+	}
+}
+
+// CronSchedule is a parsed cron expression.
+type CronSchedule struct {
+	seconds, minutes, hours, months []bool
+	// dayOfMonth and dayOfWeek match a day, they are nil if the field is ? (Quartz).
+	dayOfMonth, dayOfWeek func(t time.Time) bool
+	// domStar and dowStar report whether the field starts with * (standard day matching rule).
+	domStar, dowStar bool
+}
+
+// ParseCron parses a cron expression of the given dialect.
+// The macros (Ex: @daily) are accepted only if allowMacros is set.
+func ParseCron(value, dialect string, allowMacros bool) (*CronSchedule, error) {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, "@") {
+		expression, ok := CronMacros[strings.ToLower(value)]
+		switch {
+		case !ok:
+			return nil, fmt.Errorf("unknown macro %q", value)
+		case !allowMacros:
+			return nil, fmt.Errorf("the macros are not allowed, got %q", value)
+		}
+		return ParseCron(expression, CronDialectStandard, false)
+	}
+
+	fields := strings.Fields(value)
+
+	s := &CronSchedule{seconds: make([]bool, 60)}
+
+	var err error
+	switch dialect {
+	case CronDialectStandard:
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("expected 5 fields (%s), got %d", strings.Join(CronFields(dialect), " "), len(fields))
+		}
+		s.seconds[0] = true
+		err = s.parseStandard(fields)
+	case CronDialectSeconds:
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("expected 6 fields (%s), got %d", strings.Join(CronFields(dialect), " "), len(fields))
+		}
+		if s.seconds, err = parseCronField(fields[0], cronSecond); err == nil {
+			err = s.parseStandard(fields[1:])
+		}
+	case CronDialectQuartz:
+		if len(fields) != 6 && len(fields) != 7 {
+			return nil, fmt.Errorf("expected 6 or 7 fields (%s), got %d", strings.Join(CronFields(dialect), " "), len(fields))
+		}
+		err = s.parseQuartz(fields)
+	default:
+		return nil, fmt.Errorf("unknown cron dialect %q", dialect)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *CronSchedule) parseStandard(fields []string) (err error) {
+	if s.minutes, err = parseCronField(fields[0], cronMinute); err != nil {
+		return err
+	}
+	if s.hours, err = parseCronField(fields[1], cronHour); err != nil {
+		return err
+	}
+
+	doms, err := parseCronField(fields[2], cronDayOfMonth)
+	if err != nil {
+		return err
+	}
+	s.dayOfMonth = func(t time.Time) bool { return doms[t.Day()] }
+	s.domStar = strings.HasPrefix(fields[2], "*")
+
+	if s.months, err = parseCronField(fields[3], cronMonth); err != nil {
+		return err
+	}
+
+	dows, err := parseCronField(fields[4], cronDayOfWeek)
+	if err != nil {
+		return err
+	}
+	s.dayOfWeek = func(t time.Time) bool {
+		return dows[t.Weekday()] || (t.Weekday() == time.Sunday && dows[7])
+	}
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return nil
+}
+
+func (s *CronSchedule) parseQuartz(fields []string) (err error) {
+	if s.seconds, err = parseCronField(fields[0], cronSecond); err != nil {
+		return err
+	}
+	if s.minutes, err = parseCronField(fields[1], cronMinute); err != nil {
+		return err
+	}
+	if s.hours, err = parseCronField(fields[2], cronHour); err != nil {
+		return err
+	}
+	if s.dayOfMonth, err = parseQuartzDayOfMonth(fields[3]); err != nil {
+		return err
+	}
+	if s.months, err = parseCronField(fields[4], cronMonth); err != nil {
+		return err
+	}
+	if s.dayOfWeek, err = parseQuartzDayOfWeek(fields[5]); err != nil {
+		return err
+	}
+	if len(fields) == 7 {
+		if _, err = parseCronField(fields[6], cronQuartzYear); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case s.dayOfMonth == nil && s.dayOfWeek == nil:
+		return errors.New("? can only be used in one of the day-of-month and day-of-week fields")
+	case s.dayOfMonth != nil && s.dayOfWeek != nil:
+		return errors.New("one of the day-of-month and day-of-week fields must be ?")
+	}
+
+	return nil
+}
+
+// parseQuartzDayOfMonth parses the Quartz day-of-month field: ?, L, L-N, LW, NW or a standard field.
+func parseQuartzDayOfMonth(field string) (func(t time.Time) bool, error) {
+	switch upper := strings.ToUpper(field); {
+	case upper == "?":
+		return nil, nil
+	case upper == "L":
+		return func(t time.Time) bool { return t.Day() == daysIn(t) }, nil
+	case upper == "LW":
+		return func(t time.Time) bool { return t.Day() == nearestWeekday(t, daysIn(t)) }, nil
+	case strings.HasPrefix(upper, "L-"):
+		offset, err := parseCronNumber(upper[2:], cronFieldSpec{name: "day-of-month", min: 0, max: 30})
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool { return t.Day() == daysIn(t)-offset }, nil
+	case strings.HasSuffix(upper, "W"):
+		day, err := parseCronNumber(upper[:len(upper)-1], cronDayOfMonth)
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool { return day <= daysIn(t) && t.Day() == nearestWeekday(t, day) }, nil
+	}
+
+	doms, err := parseCronField(field, cronDayOfMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(t time.Time) bool { return doms[t.Day()] }, nil
+}
+
+// parseQuartzDayOfWeek parses the Quartz day-of-week field: ?, L, NL, N#M or a standard field.
+func parseQuartzDayOfWeek(field string) (func(t time.Time) bool, error) {
+	switch upper := strings.ToUpper(field); {
+	case upper == "?":
+		return nil, nil
+	case upper == "L":
+		return func(t time.Time) bool { return t.Weekday() == time.Saturday }, nil
+	case strings.HasSuffix(upper, "L"):
+		day, err := parseCronNumber(upper[:len(upper)-1], cronQuartzDayOfWeek)
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool {
+			return int(t.Weekday()) == day-1 && t.Day() > daysIn(t)-7
+		}, nil
+	case strings.Contains(upper, "#"):
+		parts := strings.SplitN(upper, "#", 2)
+		day, err := parseCronNumber(parts[0], cronQuartzDayOfWeek)
+		if err != nil {
+			return nil, err
+		}
+		nth, err := parseCronNumber(parts[1], cronFieldSpec{name: "day-of-week occurrence", min: 1, max: 5})
+		if err != nil {
+			return nil, err
+		}
+		return func(t time.Time) bool {
+			return int(t.Weekday()) == day-1 && (t.Day()-1)/7+1 == nth
+		}, nil
+	}
+
+	dows, err := parseCronField(field, cronQuartzDayOfWeek)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(t time.Time) bool { return dows[int(t.Weekday())+1] }, nil
+}
+
+// parseCronField parses a comma separated list of *, N, N-M with an optional /step.
+// The returned slice is indexed by value.
+func parseCronField(field string, spec cronFieldSpec) ([]bool, error) {
+	values := make([]bool, spec.max+1)
+
+	for _, item := range strings.Split(field, ",") {
+		if item == "" {
+			return nil, fmt.Errorf("the %s field %q contains an empty item", spec.name, field)
+		}
+
+		base, stepValue, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepValue)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("the %s field %q has an invalid step %q, expected a positive number", spec.name, field, stepValue)
+			}
+			if n > spec.max-spec.min {
+				return nil, fmt.Errorf("the %s field %q has a step %d greater than the range %d-%d", spec.name, field, n, spec.min, spec.max)
+			}
+			step = n
+		}
+
+		var low, high int
+		switch {
+		case base == "*":
+			low, high = spec.min, spec.max
+		case strings.Contains(base, "-"):
+			lowValue, highValue, _ := strings.Cut(base, "-")
+			var err error
+			if low, err = parseCronNumber(lowValue, spec); err != nil {
+				return nil, err
+			}
+			if high, err = parseCronNumber(highValue, spec); err != nil {
+				return nil, err
+			}
+			if low > high {
+				return nil, fmt.Errorf("the %s field %q has a decreasing range %q", spec.name, field, base)
+			}
+		default:
+			var err error
+			if low, err = parseCronNumber(base, spec); err != nil {
+				return nil, err
+			}
+			high = low
+			if hasStep {
+				// N/S is N-max/S.
+				high = spec.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			values[v] = true
+		}
+	}
+
+	return values, nil
+}
+
+// parseCronNumber parses a number or a name (Ex: JAN, MON) and checks its range.
+func parseCronNumber(value string, spec cronFieldSpec) (int, error) {
+	for i, name := range spec.names {
+		if strings.EqualFold(value, name) {
+			// The weekday names start at Sunday, which is 0 in the standard dialect and 1 in Quartz.
+			return spec.min + i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+		return 0, fmt.Errorf("the %s field has an invalid value %q", spec.name, value)
+	}
+
+	if n < spec.min || n > spec.max {
+		return 0, fmt.Errorf("the %s field value %d is out of range %d-%d", spec.name, n, spec.min, spec.max)
+	}
+
+	return n, nil
+}
+
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest to the given day of the month of t,
+// without leaving the month.
+func nearestWeekday(t time.Time, day int) int {
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysIn(t) {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+// matchDay reports whether the schedule fires on the day of t.
+func (s *CronSchedule) matchDay(t time.Time) bool {
+	if !s.months[t.Month()] {
+		return false
+	}
+
+	switch {
+	case s.dayOfMonth == nil:
+		return s.dayOfWeek(t)
+	case s.dayOfWeek == nil:
+		return s.dayOfMonth(t)
+	case s.domStar || s.dowStar:
+		return s.dayOfMonth(t) && s.dayOfWeek(t)
+	default:
+		// If both fields are restricted, the day matches if one of them matches.
+		return s.dayOfMonth(t) || s.dayOfWeek(t)
+	}
+}
+
+// cronPeriodDays is the number of days checked to compute the interval: two leap year cycles,
+// so that all the day-of-month, day-of-week and month combinations are seen at least twice.
+const cronPeriodDays = 2 * (3*365 + 366)
+
+// MinInterval returns the shortest duration between two consecutive runs of the schedule.
+// The year field is ignored and the days are computed in UTC.
+// An error is returned if the schedule never fires, false is returned if it fires at most once per period.
+func (s *CronSchedule) MinInterval() (time.Duration, bool, error) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	minDayGap, lastDay := -1, -1
+	for i := 0; i < cronPeriodDays; i++ {
+		if !s.matchDay(start.AddDate(0, 0, i)) {
+			continue
+		}
+		if lastDay >= 0 && (minDayGap < 0 || i-lastDay < minDayGap) {
+			minDayGap = i - lastDay
+		}
+		lastDay = i
+	}
+
+	if lastDay < 0 {
+		return 0, false, errors.New("the schedule never fires")
+	}
+
+	var times []int
+	for h, hour := range s.hours {
+		for m, minute := range s.minutes {
+			for sec, second := range s.seconds {
+				if hour && minute && second {
+					times = append(times, h*3600+m*60+sec)
+				}
+			}
+		}
+	}
+	sort.Ints(times)
+
+	interval := -1
+	for i := 1; i < len(times); i++ {
+		if gap := times[i] - times[i-1]; interval < 0 || gap < interval {
+			interval = gap
+		}
+	}
+
+	// The gap between the last run of a day and the first run of the next day.
+	if minDayGap > 0 {
+		if gap := minDayGap*86400 - times[len(times)-1] + times[0]; interval < 0 || gap < interval {
+			interval = gap
+		}
+	}
+
+	if interval < 0 {
+		return 0, false, nil
+	}
+
+	return time.Duration(interval) * time.Second, true, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+var durationUnits = []struct {
+	name  string
+	value time.Duration
+}{
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+	{"microsecond", time.Microsecond},
+	{"nanosecond", time.Nanosecond},
+}
+
+// FormatDuration formats a duration in human units (Ex: 1 day 12 hours, 90 seconds is 1 minute 30 seconds).
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0 seconds"
+	}
+
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	var parts []string
+	for _, unit := range durationUnits {
+		if d < unit.value {
+			continue
+		}
+
+		n := d / unit.value
+		d -= n * unit.value
+
+		name := unit.name
+		if n > 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", n, name))
+	}
+
+	return sign + strings.Join(parts, " ")
+}
+
+// iso8601DurationRegex is the ISO 8601 duration format (Ex: P1DT12H, PT5M, PT0.5S, P2W).
+var iso8601DurationRegex = common.MustCompileRegex(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:[.,](\d{1,9}))?S)?)?$`)
+
+// ParseISO8601Duration parses an ISO 8601 duration (Ex: PT1H30M).
+// The years and months are rejected because they do not have a fixed duration.
+func ParseISO8601Duration(value string) (time.Duration, error) {
+	m := iso8601DurationRegex.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("%q is not a valid ISO 8601 duration (Ex: PT1H30M)", value)
+	}
+
+	if m[1] != "" || m[2] != "" {
+		return 0, fmt.Errorf("%q contains years or months, which do not have a fixed duration", value)
+	}
+
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+3] == "" {
+			continue
+		}
+
+		n, err := strconv.ParseInt(m[i+3], 10, 64)
+		if err != nil || n > int64(math.MaxInt64/unit) || d > math.MaxInt64-time.Duration(n)*unit {
+			return 0, fmt.Errorf("%q is too large", value)
+		}
+		d += time.Duration(n) * unit
+	}
+
+	if m[8] != "" {
+		// The fraction of seconds is padded to nanoseconds (Ex: 0.5 is 500000000).
+		n, _ := strconv.ParseInt(m[8]+strings.Repeat("0", 9-len(m[8])), 10, 64)
+		if d > math.MaxInt64-time.Duration(n) {
+			return 0, fmt.Errorf("%q is too large", value)
+		}
+		d += time.Duration(n)
+	}
+
+	return d, nil
+}
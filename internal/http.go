@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import "strings"
+
+// HTTPHopByHopHeaders is the list of headers managed by the HTTP connection itself.
+// They must not be set by the user (RFC 9110 section 7.6.1 and RFC 9112).
+var HTTPHopByHopHeaders = []string{
+	"Connection",
+	"Content-Length",
+	"Host",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"TE",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// IsHTTPToken reports whether s respects the token grammar defined by RFC 9110 section 5.6.2.
+//
+//	token = 1*tchar
+//	tchar = "!" / "#" / "$" / "%" / "&" / "'" / "*" / "+" / "-" / "." /
+//	        "^" / "_" / "`" / "|" / "~" / DIGIT / ALPHA
+func IsHTTPToken(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isHTTPTokenChar(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isHTTPTokenChar(c byte) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		return true
+	default:
+		return strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+	}
+}
+
+// HTTPFieldValueInvalidChar returns the index of the first character of s
+// that is not allowed in a field value by RFC 9110 section 5.5, or -1 if s is valid.
+//
+//	field-value = *field-content
+//	field-vchar = VCHAR / obs-text
+//	allowed     = field-vchar / SP / HTAB
+func HTTPFieldValueInvalidChar(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\t' || c == ' ' || (c >= 0x21 && c != 0x7f) {
+			continue
+		}
+		return i
+	}
+
+	return -1
+}
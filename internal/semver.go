@@ -0,0 +1,369 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+// semverRegex is the grammar defined by https://semver.org/spec/v2.0.0.html (without the v prefix).
+var semverRegex = common.MustCompileRegex(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// semverPartialRegex is a version with optional minor and patch numbers used in the constraints (Ex: 1.2).
+var semverPartialRegex = common.MustCompileRegex(`^(0|[1-9]\d*)(?:\.(0|[1-9]\d*))?(?:\.(0|[1-9]\d*))?` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// Semver is a semantic version.
+type Semver struct {
+	Major, Minor, Patch uint64
+	PreRelease          string
+	Build               string
+	// Parts is the number of numeric parts given (1 to 3). Only partial versions of the constraints have less than 3 parts.
+	Parts int
+}
+
+// ParseSemver parses a semantic version (Ex: 1.2.3-rc.1+build.5) without the v prefix.
+func ParseSemver(value string) (Semver, error) {
+	m := semverRegex.FindStringSubmatch(value)
+	if m == nil {
+		return Semver{}, fmt.Errorf("%q is not a valid semantic version (MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD])", value)
+	}
+
+	return newSemver(m)
+}
+
+func parsePartialSemver(value string) (Semver, error) {
+	m := semverPartialRegex.FindStringSubmatch(value)
+	if m == nil {
+		return Semver{}, fmt.Errorf("%q is not a valid version", value)
+	}
+
+	return newSemver(m)
+}
+
+func newSemver(m []string) (Semver, error) {
+	var (
+		v   Semver
+		err error
+	)
+
+	numbers := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, n := range m[1:4] {
+		if n == "" {
+			break
+		}
+		if *numbers[i], err = strconv.ParseUint(n, 10, 64); err != nil {
+			return Semver{}, fmt.Errorf("the version number %q is too large", n)
+		}
+		v.Parts++
+	}
+
+	v.PreRelease = m[4]
+	v.Build = m[5]
+
+	return v, nil
+}
+
+// String returns the version without the v prefix.
+func (v Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
+
+// Compare returns -1, 0 or 1 following the semver precedence rules. The build metadata is ignored.
+func (v Semver) Compare(o Semver) int {
+	for _, c := range [][2]uint64{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if c[0] != c[1] {
+			if c[0] < c[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case v.PreRelease == o.PreRelease:
+		return 0
+	case v.PreRelease == "":
+		return 1
+	case o.PreRelease == "":
+		return -1
+	}
+
+	a, b := strings.Split(v.PreRelease, "."), strings.Split(o.PreRelease, ".")
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := comparePreReleaseIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func comparePreReleaseIdentifier(a, b string) int {
+	na, errA := strconv.ParseUint(a, 10, 64)
+	nb, errB := strconv.ParseUint(b, 10, 64)
+
+	switch {
+	case errA == nil && errB == nil:
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+		return 0
+	case errA == nil:
+		// Numeric identifiers have a lower precedence than alphanumeric identifiers.
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// nextMajor, nextMinor and nextPatch return the lowest release greater than all the versions
+// sharing the same major, minor or patch number.
+func (v Semver) nextMajor() Semver { return Semver{Major: v.Major + 1, Parts: 3} }
+func (v Semver) nextMinor() Semver { return Semver{Major: v.Major, Minor: v.Minor + 1, Parts: 3} }
+func (v Semver) nextPatch() Semver {
+	return Semver{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1, Parts: 3}
+}
+
+// semverBound is a bound of a version range.
+type semverBound struct {
+	version   Semver
+	inclusive bool
+	set       bool
+}
+
+// semverRange is a range of versions. A bound that is not set is infinite.
+type semverRange struct {
+	lower, upper semverBound
+	excluded     []Semver
+}
+
+func (r *semverRange) setLower(v Semver, inclusive bool) {
+	if !r.lower.set || v.Compare(r.lower.version) > 0 || (v.Compare(r.lower.version) == 0 && !inclusive) {
+		r.lower = semverBound{version: v, inclusive: inclusive, set: true}
+	}
+}
+
+func (r *semverRange) setUpper(v Semver, inclusive bool) {
+	if !r.upper.set || v.Compare(r.upper.version) < 0 || (v.Compare(r.upper.version) == 0 && !inclusive) {
+		r.upper = semverBound{version: v, inclusive: inclusive, set: true}
+	}
+}
+
+// empty reports whether no version satisfies the range.
+func (r semverRange) empty() bool {
+	if !r.lower.set || !r.upper.set {
+		return false
+	}
+
+	switch c := r.lower.version.Compare(r.upper.version); {
+	case c > 0:
+		return true
+	case c == 0:
+		if !r.lower.inclusive || !r.upper.inclusive {
+			return true
+		}
+		// The range holds a single version, it may be excluded.
+		for _, e := range r.excluded {
+			if e.Compare(r.lower.version) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// semverConstraintRegex is a single constraint: an optional operator followed by a (partial) version.
+var semverConstraintRegex = common.MustCompileRegex(`^(=|!=|>=|<=|>|<|~>|~|\^)?\s*v?(\S+)$`)
+
+// semverConstraintTokenRegex splits a group of constraints in tokens.
+var semverConstraintTokenRegex = common.MustCompileRegex(`(?:=|!=|>=|<=|>|<|~>|~|\^)?\s*[^\s,=!<>~^]+`)
+
+// ParseSemverConstraints parses a version constraint expression (Ex: ">= 1.2, < 2.0 || ~> 3.1").
+// The constraints of a group are separated by commas or spaces, the groups are separated by ||.
+// An error is returned if the syntax is invalid or if no version can satisfy the expression.
+func ParseSemverConstraints(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("the constraint is empty")
+	}
+
+	satisfiable := false
+	var unsatisfiable []string
+
+	for _, group := range strings.Split(value, "||") {
+		r, err := parseSemverConstraintGroup(group)
+		if err != nil {
+			return err
+		}
+
+		if r.empty() {
+			unsatisfiable = append(unsatisfiable, strings.TrimSpace(group))
+			continue
+		}
+
+		satisfiable = true
+	}
+
+	if !satisfiable {
+		return fmt.Errorf("no version can satisfy %q", strings.Join(unsatisfiable, " || "))
+	}
+
+	return nil
+}
+
+func parseSemverConstraintGroup(group string) (semverRange, error) {
+	var r semverRange
+
+	group = strings.TrimSpace(group)
+	if group == "" {
+		return r, errors.New("the constraint contains an empty group")
+	}
+
+	rest := group
+	for rest != "" {
+		loc := semverConstraintTokenRegex.FindStringIndex(rest)
+		if loc == nil || strings.Trim(rest[:loc[0]], " ,") != "" {
+			return r, fmt.Errorf("invalid constraint %q", strings.TrimSpace(rest))
+		}
+
+		token := strings.TrimSpace(rest[loc[0]:loc[1]])
+		rest = strings.TrimLeft(rest[loc[1]:], " ")
+		if strings.HasPrefix(rest, ",") {
+			rest = strings.TrimLeft(rest[1:], " ")
+			if rest == "" {
+				return r, fmt.Errorf("the constraint %q ends with a comma", group)
+			}
+		}
+
+		if err := r.apply(token); err != nil {
+			return r, err
+		}
+	}
+
+	return r, nil
+}
+
+// apply restricts the range with a single constraint (Ex: >= 1.2).
+func (r *semverRange) apply(constraint string) error {
+	m := semverConstraintRegex.FindStringSubmatch(constraint)
+	if m == nil {
+		return fmt.Errorf("invalid constraint %q", constraint)
+	}
+
+	v, err := parsePartialSemver(m[2])
+	if err != nil {
+		return fmt.Errorf("invalid constraint %q: %w", constraint, err)
+	}
+
+	// The partial versions are only allowed without pre-release (Ex: 1.2-rc.1 is invalid).
+	if v.Parts < 3 && v.PreRelease != "" {
+		return fmt.Errorf("invalid constraint %q: a pre-release requires a MAJOR.MINOR.PATCH version", constraint)
+	}
+
+	switch m[1] {
+	case "", "=":
+		switch v.Parts {
+		case 1:
+			r.setLower(v, true)
+			r.setUpper(v.nextMajor(), false)
+		case 2:
+			r.setLower(v, true)
+			r.setUpper(v.nextMinor(), false)
+		default:
+			r.setLower(v, true)
+			r.setUpper(v, true)
+		}
+	case "!=":
+		if v.Parts == 3 {
+			r.excluded = append(r.excluded, v)
+		}
+	case ">":
+		switch v.Parts {
+		case 1:
+			r.setLower(v.nextMajor(), true)
+		case 2:
+			r.setLower(v.nextMinor(), true)
+		default:
+			r.setLower(v, false)
+		}
+	case ">=":
+		r.setLower(v, true)
+	case "<":
+		r.setUpper(v, false)
+	case "<=":
+		switch v.Parts {
+		case 1:
+			r.setUpper(v.nextMajor(), false)
+		case 2:
+			r.setUpper(v.nextMinor(), false)
+		default:
+			r.setUpper(v, true)
+		}
+	case "~>":
+		// Pessimistic constraint: only the rightmost number may increase (~> 1.2 is >= 1.2, < 2.0).
+		r.setLower(v, true)
+		switch v.Parts {
+		case 1, 2:
+			r.setUpper(v.nextMajor(), false)
+		default:
+			r.setUpper(v.nextMinor(), false)
+		}
+	case "~":
+		// Tilde range: patch updates if the minor is given (~1.2.3 is >= 1.2.3, < 1.3.0).
+		r.setLower(v, true)
+		if v.Parts == 1 {
+			r.setUpper(v.nextMajor(), false)
+		} else {
+			r.setUpper(v.nextMinor(), false)
+		}
+	case "^":
+		// Caret range: updates that do not modify the leftmost non-zero number.
+		r.setLower(v, true)
+		switch {
+		case v.Major > 0 || v.Parts == 1:
+			r.setUpper(v.nextMajor(), false)
+		case v.Minor > 0 || v.Parts == 2:
+			r.setUpper(v.nextMinor(), false)
+		default:
+			r.setUpper(v.nextPatch(), false)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,243 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// SSH key families.
+const (
+	SSHKeyTypeED25519 = "ed25519"
+	SSHKeyTypeECDSA   = "ecdsa"
+	SSHKeyTypeRSA     = "rsa"
+
+	// SSHDefaultMinRSAKeySize is the default minimum modulus size in bits of the RSA keys.
+	SSHDefaultMinRSAKeySize = 2048
+)
+
+// sshKeyAlgorithms maps the SSH key algorithm names to their family.
+var sshKeyAlgorithms = map[string]string{
+	"ssh-ed25519":         SSHKeyTypeED25519,
+	"ecdsa-sha2-nistp256": SSHKeyTypeECDSA,
+	"ecdsa-sha2-nistp384": SSHKeyTypeECDSA,
+	"ecdsa-sha2-nistp521": SSHKeyTypeECDSA,
+	"ssh-rsa":             SSHKeyTypeRSA,
+}
+
+// SSHAuthorizedKey is a parsed authorized_keys line (sshd(8) AUTHORIZED_KEYS FILE FORMAT).
+type SSHAuthorizedKey struct {
+	// Options is the raw options field (Ex: from="10.0.0.0/8",no-pty). Empty if not set.
+	Options string
+	// Algorithm is the declared key algorithm (Ex: ssh-ed25519).
+	Algorithm string
+	// Type is the key family (ed25519, ecdsa or rsa).
+	Type string
+	// Blob is the decoded public key.
+	Blob []byte
+	// Comment is the trailing comment. Empty if not set.
+	Comment string
+	// Size is the size of the key in bits (modulus size for RSA, curve size for ECDSA, 256 for Ed25519).
+	Size int
+}
+
+// Fingerprint returns the SHA256 fingerprint of the key as printed by ssh-keygen -l.
+func (k SSHAuthorizedKey) Fingerprint() string {
+	sum := sha256.Sum256(k.Blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+// CheckSSHAuthorizedKey parses an authorized_keys line (See ParseSSHAuthorizedKey) and checks
+// that the key type is one of keyTypes and that the RSA keys have at least minRSAKeySize bits.
+// If keyTypes is empty, all the key types are allowed. If minRSAKeySize is 0, SSHDefaultMinRSAKeySize is used.
+func CheckSSHAuthorizedKey[T ~string](line string, keyTypes []T, minRSAKeySize int) (SSHAuthorizedKey, error) {
+	key, err := ParseSSHAuthorizedKey(line)
+	if err != nil {
+		return key, err
+	}
+
+	allowed := len(keyTypes) == 0
+	for _, t := range keyTypes {
+		if string(t) == key.Type {
+			allowed = true
+			break
+		}
+	}
+
+	if !allowed {
+		return key, fmt.Errorf("the key type %s is not allowed", key.Algorithm)
+	}
+
+	if minRSAKeySize <= 0 {
+		minRSAKeySize = SSHDefaultMinRSAKeySize
+	}
+
+	if key.Type == SSHKeyTypeRSA && key.Size < minRSAKeySize {
+		return key, fmt.Errorf("the RSA key size is %d bits, expected at least %d bits", key.Size, minRSAKeySize)
+	}
+
+	return key, nil
+}
+
+// ParseSSHAuthorizedKey parses an authorized_keys line: [options] keytype base64-key [comment].
+// The key blob must decode and its embedded algorithm must match the declared one.
+func ParseSSHAuthorizedKey(line string) (SSHAuthorizedKey, error) {
+	var key SSHAuthorizedKey
+
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return key, errors.New("the value is empty")
+	}
+
+	if strings.ContainsAny(line, "\r\n") {
+		return key, errors.New("the value must be a single line")
+	}
+
+	fields := strings.Fields(line)
+	if !isSSHKeyAlgorithm(fields[0]) {
+		// The line starts with the options, they may contain quoted spaces.
+		options, rest, err := splitSSHOptions(line)
+		if err != nil {
+			return key, err
+		}
+		key.Options = options
+		fields = strings.Fields(rest)
+	}
+
+	if len(fields) < 2 {
+		return key, errors.New("expected the form [options] keytype base64-key [comment]")
+	}
+
+	key.Algorithm = fields[0]
+	key.Comment = strings.Join(fields[2:], " ")
+
+	keyType, ok := sshKeyAlgorithms[key.Algorithm]
+	if !ok {
+		return key, fmt.Errorf("the key type %q is not supported", key.Algorithm)
+	}
+	key.Type = keyType
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return key, errors.New("the key is not valid base64")
+	}
+	key.Blob = blob
+
+	if key.Size, err = parseSSHKeyBlob(key.Algorithm, blob); err != nil {
+		return key, err
+	}
+
+	return key, nil
+}
+
+// isSSHKeyAlgorithm reports whether the field looks like a key algorithm name, supported or not
+// (Ex: ssh-ed25519, ssh-dss, sk-ssh-ed25519@openssh.com), rather than the options field.
+func isSSHKeyAlgorithm(field string) bool {
+	for _, prefix := range []string{"ssh-", "ecdsa-", "sk-"} {
+		if strings.HasPrefix(field, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitSSHOptions splits the options field from the rest of the line.
+func splitSSHOptions(line string) (options, rest string, err error) {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\' && quoted && i+1 < len(line):
+			i++
+		case c == '"':
+			quoted = !quoted
+		case (c == ' ' || c == '\t') && !quoted:
+			return line[:i], line[i:], nil
+		}
+	}
+
+	if quoted {
+		return "", "", errors.New("the options contain an unterminated quoted string")
+	}
+
+	return "", "", errors.New("expected the form [options] keytype base64-key [comment]")
+}
+
+// parseSSHKeyBlob checks the SSH wire format of the key (RFC 4253 section 6.6, RFC 5656, RFC 8709)
+// and returns its size in bits.
+func parseSSHKeyBlob(algorithm string, blob []byte) (int, error) {
+	embedded, blob, ok := readSSHString(blob)
+	if !ok {
+		return 0, errors.New("the key blob is truncated")
+	}
+
+	if string(embedded) != algorithm {
+		return 0, fmt.Errorf("the key blob holds a %q key, declared as %q", embedded, algorithm)
+	}
+
+	var size int
+
+	switch sshKeyAlgorithms[algorithm] {
+	case SSHKeyTypeED25519:
+		var point []byte
+		if point, blob, ok = readSSHString(blob); !ok || len(point) != 32 {
+			return 0, errors.New("the Ed25519 key blob is invalid")
+		}
+		size = 256
+	case SSHKeyTypeECDSA:
+		var curve, point []byte
+		if curve, blob, ok = readSSHString(blob); !ok || "ecdsa-sha2-"+string(curve) != algorithm {
+			return 0, errors.New("the ECDSA key blob is invalid")
+		}
+		if point, blob, ok = readSSHString(blob); !ok || len(point) == 0 || point[0] != 4 {
+			return 0, errors.New("the ECDSA key blob is invalid")
+		}
+		size = map[string]int{"nistp256": 256, "nistp384": 384, "nistp521": 521}[string(curve)]
+		if len(point) != 1+2*((size+7)/8) {
+			return 0, errors.New("the ECDSA key blob is invalid")
+		}
+	case SSHKeyTypeRSA:
+		var e, n []byte
+		if e, blob, ok = readSSHString(blob); !ok || len(e) == 0 {
+			return 0, errors.New("the RSA key blob is invalid")
+		}
+		if n, blob, ok = readSSHString(blob); !ok || len(n) == 0 {
+			return 0, errors.New("the RSA key blob is invalid")
+		}
+		size = new(big.Int).SetBytes(n).BitLen()
+	}
+
+	if len(blob) != 0 {
+		return 0, errors.New("the key blob contains trailing data")
+	}
+
+	return size, nil
+}
+
+// readSSHString reads a length-prefixed string of the SSH wire format.
+func readSSHString(data []byte) (value, rest []byte, ok bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+
+	length := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	if uint64(len(data)) < uint64(length) {
+		return nil, nil, false
+	}
+
+	return data[:length], data[length:], true
+}
@@ -0,0 +1,36 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+func TestCheckSSHAuthorizedKey(t *testing.T) {
+	t.Parallel()
+
+	const ed25519Key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOYSqgYMK4Xy5WA1EFQg+JOEYkrJgafCHK8/3cipLH43 user@example.com"
+
+	// ssh-keygen -lf key.pub
+	key, err := internal.CheckSSHAuthorizedKey[string](ed25519Key, nil, 0)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	if want := "SHA256:gBKXEhh74YOfDBB6+1W+wmYVWg4d8Y5rZ4qpYwJF4gs"; key.Fingerprint() != want {
+		t.Errorf("expected fingerprint %q, got %q", want, key.Fingerprint())
+	}
+
+	if _, err := internal.CheckSSHAuthorizedKey(ed25519Key, []string{internal.SSHKeyTypeRSA}, 0); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
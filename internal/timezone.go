@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	// The timezone database is embedded in the provider, the names are resolved even if the system has no timezone files.
+	_ "time/tzdata"
+)
+
+// Kinds of timezones.
+const (
+	// TimezoneKindZone is a canonical zone (Ex: Europe/Paris).
+	TimezoneKindZone = "zone"
+	// TimezoneKindEtc is an Etc/* zone or one of its aliases (Ex: Etc/GMT+2, UTC).
+	TimezoneKindEtc = "etc"
+	// TimezoneKindAlias is a legacy alias of a zone (Ex: US/Eastern, Asia/Calcutta).
+	TimezoneKindAlias = "alias"
+)
+
+// Timezone is an IANA timezone of the embedded timezone database.
+type Timezone struct {
+	Name string
+	Kind string
+	// Countries is the list of ISO 3166-1 alpha-2 codes of the countries using the zone.
+	Countries []string
+}
+
+//go:embed timezones.txt
+var timezonesData string
+
+var (
+	timezonesOnce sync.Once
+	timezones     map[string]Timezone
+)
+
+// LookupTimezone returns the metadata of the timezone with the given case-sensitive name
+// from the list bundled with the module.
+func LookupTimezone(name string) (Timezone, bool) {
+	timezonesOnce.Do(func() {
+		timezones = make(map[string]Timezone)
+		for _, line := range strings.Split(timezonesData, "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 3 || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			tz := Timezone{Name: fields[0], Kind: fields[1]}
+			if fields[2] != "-" {
+				tz.Countries = strings.Split(fields[2], ",")
+			}
+			timezones[tz.Name] = tz
+		}
+	})
+
+	tz, ok := timezones[name]
+	return tz, ok
+}
+
+// LoadTimezone checks that the name is an IANA timezone and loads its location with time.LoadLocation.
+// The embedded timezone database (time/tzdata) is used when the system has no timezone files.
+// The kind and the countries of the timezone come from the list bundled with the module,
+// a zone missing from the list is returned without countries.
+func LoadTimezone(name string) (Timezone, *time.Location, error) {
+	// time.LoadLocation returns UTC and the local timezone for these names, they are not IANA timezones.
+	if name == "" || name == "Local" {
+		return Timezone{}, nil, fmt.Errorf("%q is not a valid IANA timezone (Ex: Europe/Paris)", name)
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return Timezone{}, nil, fmt.Errorf("%q is not a valid IANA timezone (Ex: Europe/Paris)", name)
+	}
+
+	tz, ok := LookupTimezone(name)
+	if !ok {
+		tz = Timezone{Name: name, Kind: TimezoneKindZone}
+		if strings.HasPrefix(name, "Etc/") {
+			tz.Kind = TimezoneKindEtc
+		}
+	}
+
+	return tz, location, nil
+}
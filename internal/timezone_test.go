@@ -0,0 +1,86 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+// TestTimezonesList checks that every entry of the bundled list exists in the embedded timezone database.
+// The test is not parallel: ZONEINFO is read by the first call to time.LoadLocation.
+func TestTimezonesList(t *testing.T) {
+	t.Setenv("ZONEINFO", "/nonexistent")
+
+	data, err := os.ReadFile("timezones.txt")
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tz, _, err := internal.LoadTimezone(fields[0])
+		if err != nil {
+			t.Errorf("the timezone %q is missing from the embedded database: %s", fields[0], err)
+			continue
+		}
+		if tz.Kind != fields[1] {
+			t.Errorf("expected kind %q for %q, got %q", fields[1], fields[0], tz.Kind)
+		}
+	}
+}
+
+func TestLoadTimezone(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name        string
+		kind        string
+		expectError bool
+	}{
+		"zone":    {name: "Europe/Paris", kind: internal.TimezoneKindZone},
+		"etc":     {name: "Etc/GMT+2", kind: internal.TimezoneKindEtc},
+		"alias":   {name: "US/Eastern", kind: internal.TimezoneKindAlias},
+		"empty":   {name: "", expectError: true},
+		"local":   {name: "Local", expectError: true},
+		"unknown": {name: "Europe/Atlantis", expectError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tz, location, err := internal.LoadTimezone(test.name)
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tz.Kind != test.kind {
+				t.Errorf("expected kind %q, got %q", test.kind, tz.Kind)
+			}
+			if location.String() != test.name {
+				t.Errorf("expected location %q, got %q", test.name, location.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// ParsePEMCertificates parses the CERTIFICATE blocks of a PEM encoded string.
+// The value must contain at least one certificate and nothing else than PEM blocks.
+func ParsePEMCertificates(value string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	rest := []byte(value)
+	for len(bytes.TrimSpace(rest)) > 0 {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			if len(certificates) == 0 {
+				return nil, errors.New("the value does not contain any PEM block")
+			}
+			return nil, errors.New("the value contains data that is not a PEM block")
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("the PEM block %d is a %q, expected a \"CERTIFICATE\"", len(certificates)+1, block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("the PEM block %d is not a valid X.509 certificate: %w", len(certificates)+1, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("the value does not contain any PEM block")
+	}
+
+	return certificates, nil
+}
+
+// ErrEncryptedPrivateKey is returned by ParsePEMPrivateKey when the private key is encrypted.
+var ErrEncryptedPrivateKey = errors.New("the private key is encrypted")
+
+// ParsePEMPrivateKey parses a PEM encoded private key (PKCS#1, PKCS#8 or SEC 1 EC key).
+// The value must contain a single PEM block, a leading EC PARAMETERS block is skipped.
+// If the key is encrypted, ErrEncryptedPrivateKey is returned.
+// The returned errors never contain the key material.
+func ParsePEMPrivateKey(value string) (crypto.Signer, error) {
+	block, rest := pem.Decode([]byte(value))
+	// openssl ecparam -genkey writes the curve parameters before the EC private key.
+	if block != nil && block.Type == "EC PARAMETERS" {
+		block, rest = pem.Decode(rest)
+	}
+	if block == nil {
+		return nil, errors.New("the value does not contain any PEM block")
+	}
+
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return nil, errors.New("the value must contain a single PEM block")
+	}
+
+	// Legacy OpenSSL encryption (RFC 1421) adds a Proc-Type header to the PKCS#1 or SEC 1 block.
+	if block.Type == "ENCRYPTED PRIVATE KEY" || block.Headers["Proc-Type"] == "4,ENCRYPTED" {
+		return nil, ErrEncryptedPrivateKey
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("the PEM block is a %q, expected a \"PRIVATE KEY\", \"RSA PRIVATE KEY\" or \"EC PRIVATE KEY\"", block.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("the %q PEM block is not a valid private key", block.Type)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("the private key type %T is not supported", key)
+	}
+
+	return signer, nil
+}
+
+// PublicKeySize returns the size in bits of a public key
+// (modulus size for RSA, curve size for ECDSA, 256 for Ed25519).
+// It returns 0 if the key type is not supported.
+func PublicKeySize(publicKey any) int {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return 256
+	default:
+		return 0
+	}
+}
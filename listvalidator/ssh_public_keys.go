@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+var _ validator.List = sshPublicKeys{}
+
+type sshPublicKeys struct {
+	params stringvalidator.SSHPublicKeyParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator sshPublicKeys) Description(ctx context.Context) string {
+	return fmt.Sprintf("Each element: %s. The keys must be unique", stringvalidator.SSHPublicKey(validator.params).Description(ctx))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator sshPublicKeys) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Each element: %s. The keys must be unique", stringvalidator.SSHPublicKey(validator.params).MarkdownDescription(ctx))
+}
+
+// Validate performs the validation.
+func (validator sshPublicKeys) ValidateList(
+	ctx context.Context,
+	request validator.ListRequest,
+	response *validator.ListResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// The index of the first occurrence of each fingerprint.
+	fingerprints := make(map[string]int)
+
+	for i, element := range request.ConfigValue.Elements() {
+		elementPath := request.Path.AtListIndex(i)
+
+		value, ok := element.(types.String)
+		if !ok {
+			response.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid SSH public key",
+				fmt.Sprintf("The element type %s is not a string", element.Type(ctx)),
+			)
+			continue
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		key, err := internal.CheckSSHAuthorizedKey(value.ValueString(), validator.params.KeyTypes, validator.params.MinRSAKeySize)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				elementPath,
+				"Invalid SSH public key",
+				err.Error(),
+			)
+			continue
+		}
+
+		fingerprint := key.Fingerprint()
+		if first, ok := fingerprints[fingerprint]; ok {
+			response.Diagnostics.AddAttributeError(
+				elementPath,
+				"Duplicate SSH public key",
+				fmt.Sprintf("The key %s is already defined at index %d", fingerprint, first),
+			)
+			continue
+		}
+
+		fingerprints[fingerprint] = i
+	}
+}
+
+// SSHPublicKeys returns a validator which ensures that each element of the list
+// is a SSH public key in the authorized_keys format (See stringvalidator.SSHPublicKey)
+// and that the same key is not defined twice.
+//
+// Two keys are the same if they have the same fingerprint, whatever their options and comments.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func SSHPublicKeys(settings stringvalidator.SSHPublicKeyParams) validator.List {
+	return &sshPublicKeys{
+		params: settings,
+	}
+}
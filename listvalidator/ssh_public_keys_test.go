@@ -0,0 +1,114 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package listvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/listvalidator"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// Keys generated with ssh-keygen.
+const (
+	testSSHKeyED25519 = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOYSqgYMK4Xy5WA1EFQg+JOEYkrJgafCHK8/3cipLH43"
+	testSSHKeyRSA2048 = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDEiudT+FemFGf5f+ZZ7M7OQYo6lF+dvr1fvrlIddsFBoNv9R47iaJOoqki7uVtQFiqctMSsMIm6Ag+SvA4OQLv9u1dHg3c9QwCUMKnTBrbX/IVj4iPKqaAN/zBX5CeUR5jap9T38FR0anuOazq2WF6Gxf1X/oDSBLMiVgj7jOtZ7foJRUX1w5htHUHllgudnnIXWtDuJqXHzJv127bRcfei/zIb4bIm9g0tQNbOwMjzMN2x5TyOfyIlqWxYaWbcO5dWKeiu9JnDcz3W67XKARQ5TMo8/5ogkKJZb1X/BIrLPxiC3dVTNbCfa6wwxilg49J8ciZI9slWTCgiibTC06r"
+)
+
+func TestSSHPublicKeysValidator(t *testing.T) {
+	t.Parallel()
+
+	keys := func(values ...attr.Value) types.List {
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	type testCase struct {
+		val         types.List
+		param       stringvalidator.SSHPublicKeyParams
+		expectError bool
+		errorPath   path.Path
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.ListUnknown(types.StringType),
+		},
+		"null": {
+			val: types.ListNull(types.StringType),
+		},
+		"valid": {
+			val: keys(
+				types.StringValue(testSSHKeyED25519+" user@example.com"),
+				types.StringValue(testSSHKeyRSA2048),
+				types.StringUnknown(),
+			),
+		},
+		"invalid-key": {
+			val: keys(
+				types.StringValue(testSSHKeyED25519),
+				types.StringValue("ssh-ed25519 AAAA"),
+			),
+			expectError: true,
+			errorPath:   path.Root("ssh_public_keys").AtListIndex(1),
+		},
+		"invalid-type-not-allowed": {
+			val: keys(
+				types.StringValue(testSSHKeyRSA2048),
+			),
+			param: stringvalidator.SSHPublicKeyParams{
+				KeyTypes: []stringvalidator.SSHKeyType{stringvalidator.SSHKeyTypeED25519},
+			},
+			expectError: true,
+			errorPath:   path.Root("ssh_public_keys").AtListIndex(0),
+		},
+		"invalid-duplicate": {
+			val: keys(
+				types.StringValue(testSSHKeyED25519+" laptop"),
+				types.StringValue(testSSHKeyRSA2048),
+				types.StringValue("no-pty "+testSSHKeyED25519+" desktop"),
+			),
+			expectError: true,
+			errorPath:   path.Root("ssh_public_keys").AtListIndex(2),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.ListRequest{
+				Path:        path.Root("ssh_public_keys"),
+				ConfigValue: test.val,
+			}
+			response := validator.ListResponse{}
+			listvalidator.SSHPublicKeys(test.param).ValidateList(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.expectError {
+				d, ok := response.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || !d.Path().Equal(test.errorPath) {
+					t.Fatalf("expected error on path %s, got %v", test.errorPath, response.Diagnostics.Errors()[0])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.Map = httpHeaders{}
+
+type httpHeaders struct {
+	forbiddenHeaders []string
+}
+
+// Description describes the validation in plain text formatting.
+func (validator httpHeaders) Description(_ context.Context) string {
+	description := "The keys must be valid HTTP header names and the values must be valid HTTP header values"
+	if len(validator.forbiddenHeaders) > 0 {
+		description += fmt.Sprintf(". The following headers are not allowed: %s", strings.Join(validator.forbiddenHeaders, ", "))
+	}
+
+	return description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator httpHeaders) MarkdownDescription(_ context.Context) string {
+	description := "The keys must be valid HTTP header names and the values must be valid HTTP header values"
+	if len(validator.forbiddenHeaders) > 0 {
+		description += fmt.Sprintf(". The following headers are not allowed: `%s`", strings.Join(validator.forbiddenHeaders, "`, `"))
+	}
+
+	return description
+}
+
+// Validate performs the validation.
+func (validator httpHeaders) ValidateMap(
+	ctx context.Context,
+	request validator.MapRequest,
+	response *validator.MapResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	headers := map[string]types.String{}
+	if diags := request.ConfigValue.ElementsAs(ctx, &headers, true); diags.HasError() {
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Sort the keys to get a deterministic order of diagnostics
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := headers[name]
+		p := request.Path.AtMapKey(name)
+
+		if !internal.IsHTTPToken(name) {
+			response.Diagnostics.AddAttributeError(
+				p,
+				"Invalid HTTP header name",
+				fmt.Sprintf("The value %q is not a valid HTTP header name defined by the HTTP RFC9110", name),
+			)
+			continue
+		}
+
+		if validator.isForbidden(name) {
+			response.Diagnostics.AddAttributeError(
+				p,
+				"Invalid HTTP header name",
+				fmt.Sprintf("The HTTP header %q is managed by the HTTP connection and cannot be set", name),
+			)
+			continue
+		}
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if i := internal.HTTPFieldValueInvalidChar(value.ValueString()); i >= 0 {
+			response.Diagnostics.AddAttributeError(
+				p,
+				"Invalid HTTP header value",
+				fmt.Sprintf("The value of the HTTP header %q contains a forbidden control character (0x%02X) at position %d", name, value.ValueString()[i], i),
+			)
+			continue
+		}
+
+		// Leading and trailing whitespaces are excluded from the field value (RFC 9110 section 5.5)
+		if strings.TrimLeft(value.ValueString(), " \t") != value.ValueString() || strings.TrimRight(value.ValueString(), " \t") != value.ValueString() {
+			response.Diagnostics.AddAttributeError(
+				p,
+				"Invalid HTTP header value",
+				fmt.Sprintf("The value of the HTTP header %q must not start or end with a whitespace", name),
+			)
+		}
+	}
+}
+
+func (validator httpHeaders) isForbidden(name string) bool {
+	for _, h := range validator.forbiddenHeaders {
+		// Header names are case-insensitive (RFC 9110 section 5.1)
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type HTTPHeadersParams struct {
+	// AllowHopByHopHeaders allows the headers managed by the HTTP connection
+	// (Ex: Host, Content-Length, Connection). They are rejected by default.
+	AllowHopByHopHeaders bool
+	// ForbiddenHeaders is an additional list of headers that are not allowed (Ex: Authorization).
+	ForbiddenHeaders []string
+}
+
+// HTTPHeaders validates that a map of strings represents valid HTTP headers.
+// The keys are the header names and the values are the header values.
+//
+// Parameters:
+//   - settings: HTTPHeadersParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.Map: A validator that checks if the map contains valid HTTP headers.
+func HTTPHeaders(settings HTTPHeadersParams) validator.Map {
+	forbiddenHeaders := make([]string, 0, len(internal.HTTPHopByHopHeaders)+len(settings.ForbiddenHeaders))
+	if !settings.AllowHopByHopHeaders {
+		forbiddenHeaders = append(forbiddenHeaders, internal.HTTPHopByHopHeaders...)
+	}
+	forbiddenHeaders = append(forbiddenHeaders, settings.ForbiddenHeaders...)
+
+	return &httpHeaders{
+		forbiddenHeaders: forbiddenHeaders,
+	}
+}
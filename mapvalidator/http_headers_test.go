@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package mapvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHTTPHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+
+	type testCase struct {
+		val         types.Map
+		param       HTTPHeadersParams
+		expectError bool
+		errorPath   path.Path
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.MapUnknown(types.StringType),
+		},
+		"null": {
+			val: types.MapNull(types.StringType),
+		},
+		"valid": {
+			val: headers(map[string]string{
+				"X-Custom-Header": "value",
+				"Accept":          "application/json; q=0.9",
+				"Authorization":   "Bearer abc\tdef",
+			}),
+		},
+		"valid-hop-by-hop-allowed": {
+			val: headers(map[string]string{
+				"Host": "example.com",
+			}),
+			param: HTTPHeadersParams{
+				AllowHopByHopHeaders: true,
+			},
+		},
+		"valid-unknown-value": {
+			val: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"X-Custom-Header": types.StringUnknown(),
+			}),
+		},
+		"invalid-name": {
+			val: headers(map[string]string{
+				"X Custom": "value",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("X Custom"),
+		},
+		"invalid-name-empty": {
+			val: headers(map[string]string{
+				"": "value",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey(""),
+		},
+		"invalid-hop-by-hop": {
+			val: headers(map[string]string{
+				"X-Custom-Header": "value",
+				"content-length":  "10",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("content-length"),
+		},
+		"invalid-forbidden": {
+			val: headers(map[string]string{
+				"Authorization": "Bearer abc",
+			}),
+			param: HTTPHeadersParams{
+				ForbiddenHeaders: []string{"Authorization"},
+			},
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("Authorization"),
+		},
+		"invalid-value-newline": {
+			val: headers(map[string]string{
+				"X-Custom-Header": "value\r\nX-Injected: true",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("X-Custom-Header"),
+		},
+		"invalid-value-null-byte": {
+			val: headers(map[string]string{
+				"X-Custom-Header": "val\x00ue",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("X-Custom-Header"),
+		},
+		"invalid-value-trailing-space": {
+			val: headers(map[string]string{
+				"X-Custom-Header": "value ",
+			}),
+			expectError: true,
+			errorPath:   path.Root("headers").AtMapKey("X-Custom-Header"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.MapRequest{
+				Path:        path.Root("headers"),
+				ConfigValue: test.val,
+			}
+			response := validator.MapResponse{}
+			HTTPHeaders(test.param).ValidateMap(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.expectError {
+				d, ok := response.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				if !ok || !d.Path().Equal(test.errorPath) {
+					t.Fatalf("expected error on path %s, got %v", test.errorPath, response.Diagnostics.Errors()[0])
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPHeadersDescription(t *testing.T) {
+	t.Parallel()
+
+	v := HTTPHeaders(HTTPHeadersParams{
+		AllowHopByHopHeaders: true,
+		ForbiddenHeaders:     []string{"Authorization", "Cookie"},
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The keys must be valid HTTP header names and the values must be valid HTTP header values. The following headers are not allowed: Authorization, Cookie"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The keys must be valid HTTP header names and the values must be valid HTTP header values. The following headers are not allowed: `Authorization`, `Cookie`"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
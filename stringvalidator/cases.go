@@ -21,6 +21,7 @@ package stringvalidator
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
@@ -34,6 +35,15 @@ const (
 	CasesDisallowNumber CasesValidatorType = "disallow_number"
 	CasesDisallowSpace  CasesValidatorType = "disallow_space"
 	CasesDisallowLower  CasesValidatorType = "disallow_lower"
+
+	CasesDisallowSpecial CasesValidatorType = "disallow_special"
+
+	CasesSnakeCase          CasesValidatorType = "snake_case"
+	CasesKebabCase          CasesValidatorType = "kebab_case"
+	CasesCamelCase          CasesValidatorType = "camel_case"
+	CasesPascalCase         CasesValidatorType = "pascal_case"
+	CasesScreamingSnakeCase CasesValidatorType = "screaming_snake_case"
+	CasesDotCase            CasesValidatorType = "dot_case"
 )
 
 var casesTypesFunc = map[CasesValidatorType]func() validator.String{
@@ -41,37 +51,59 @@ var casesTypesFunc = map[CasesValidatorType]func() validator.String{
 	CasesDisallowNumber: casesTypes.DisallowNumber,
 	CasesDisallowSpace:  casesTypes.DisallowSpace,
 	CasesDisallowLower:  casesTypes.DisallowLower,
+
+	CasesDisallowSpecial: casesTypes.DisallowSpecial,
+
+	CasesSnakeCase:          casesTypes.SnakeCase,
+	CasesKebabCase:          casesTypes.KebabCase,
+	CasesCamelCase:          casesTypes.CamelCase,
+	CasesPascalCase:         casesTypes.PascalCase,
+	CasesScreamingSnakeCase: casesTypes.ScreamingSnakeCase,
+	CasesDotCase:            casesTypes.DotCase,
 }
 
 type CasesValidatorType string
 
 type casesValidator struct {
 	CasesTypes []CasesValidatorType
+	Rules      []casesTypes.Validator
 }
 
-// Description describes the validation in plain text formatting.
-func (validatorCase casesValidator) Description(_ context.Context) string {
-	description := ""
-
-	if len(validatorCase.CasesTypes) == 0 {
-		description += "invalid configuration"
+// validators returns the validators of the case types followed by the rules.
+// An error is returned for an unknown case type.
+func (validatorCase casesValidator) validators() ([]validator.String, error) {
+	validators := make([]validator.String, 0, len(validatorCase.CasesTypes)+len(validatorCase.Rules))
+	for _, caseType := range validatorCase.CasesTypes {
+		f, ok := casesTypesFunc[caseType]
+		if !ok {
+			return nil, fmt.Errorf("invalid case type: %s", caseType)
+		}
+		validators = append(validators, f())
+	}
+	for _, rule := range validatorCase.Rules {
+		validators = append(validators, rule)
 	}
 
+	return validators, nil
+}
+
+// Description describes the validation in plain text formatting.
+func (validatorCase casesValidator) Description(ctx context.Context) string {
+	validators, err := validatorCase.validators()
+
 	switch {
-	case len(validatorCase.CasesTypes) > 1:
-		description += "The value must respect the following rules : "
-	case len(validatorCase.CasesTypes) == 1:
-		description += "The value must respect the following rule : "
+	case err != nil, len(validators) == 0:
+		return "invalid configuration"
+	case len(validators) == 1:
+		return "The value must respect the following rule : " + validators[0].Description(ctx)
 	}
 
-	for i, caseType := range validatorCase.CasesTypes {
-		if i == len(validatorCase.CasesTypes)-1 {
-			description += casesTypesFunc[caseType]().Description(context.Background())
-		} else {
-			description += fmt.Sprintf("%s, ", casesTypesFunc[caseType]().Description(context.Background()))
-		}
+	descriptions := make([]string, 0, len(validators))
+	for _, v := range validators {
+		descriptions = append(descriptions, v.Description(ctx))
 	}
-	return description
+
+	return "The value must respect the following rules : " + strings.Join(descriptions, ", ")
 }
 
 // MarkdownDescription describes the validation in Markdown formatting.
@@ -89,7 +121,7 @@ func (validatorCase casesValidator) ValidateString(
 		return
 	}
 
-	if len(validatorCase.CasesTypes) == 0 {
+	if len(validatorCase.CasesTypes) == 0 && len(validatorCase.Rules) == 0 {
 		response.Diagnostics.AddError(
 			fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
 			"Set at least one case type",
@@ -97,17 +129,19 @@ func (validatorCase casesValidator) ValidateString(
 		return
 	}
 
-	for _, caseType := range validatorCase.CasesTypes {
-		if _, ok := casesTypesFunc[caseType]; !ok {
-			response.Diagnostics.AddError(
-				"Invalid case type",
-				fmt.Sprintf("invalid case type: %s", caseType),
-			)
-			continue
-		}
+	validators, err := validatorCase.validators()
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			err.Error(),
+		)
+		return
+	}
 
+	for _, v := range validators {
 		resp := new(validator.StringResponse)
-		casesTypesFunc[caseType]().ValidateString(ctx, request, resp)
+		v.ValidateString(ctx, request, resp)
 
 		if resp.Diagnostics.HasError() {
 			response.Diagnostics.Append(resp.Diagnostics...)
@@ -117,13 +151,18 @@ func (validatorCase casesValidator) ValidateString(
 
 // Cases returns a new string validator that checks if the string matches any of the specified case types.
 //
+// The configurable rules of the cases package (Ex: cases.AllowedCharacters, cases.FirstCharacter)
+// are checked after the case types.
+//
 // Parameters:
-//   - casesTypes: A slice of CasesValidatorType that specifies the types of cases to validate against.
+//   - types: A slice of CasesValidatorType that specifies the types of cases to validate against.
+//   - rules: The configurable rules to validate against.
 //
 // Returns:
 //   - validator.String: A string validator that validates the string against the specified case types.
-func Cases(casesTypes []CasesValidatorType) validator.String {
+func Cases(types []CasesValidatorType, rules ...casesTypes.Validator) validator.String {
 	return &casesValidator{
-		CasesTypes: casesTypes,
+		CasesTypes: types,
+		Rules:      rules,
 	}
 }
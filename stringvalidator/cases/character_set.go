@@ -0,0 +1,181 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// ASCIILowercase is the list of the ASCII lowercase letters.
+	ASCIILowercase = "abcdefghijklmnopqrstuvwxyz"
+	// ASCIIUppercase is the list of the ASCII uppercase letters.
+	ASCIIUppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// ASCIILetters is the list of the ASCII letters.
+	ASCIILetters = ASCIILowercase + ASCIIUppercase
+	// ASCIIDigits is the list of the ASCII digits.
+	ASCIIDigits = "0123456789"
+)
+
+// CharacterSet is a set of characters made of a list of runes and Unicode categories.
+// A character belongs to the set if it is one of the runes or if it belongs to one of the categories.
+type CharacterSet struct {
+	// Runes is the list of the characters of the set (Ex: ASCIILetters + ASCIIDigits + "-_").
+	Runes string
+	// Categories is the list of the Unicode categories of the set (Ex: L for the letters, Lu for the uppercase letters, Nd for the decimal digits).
+	// See unicode.Categories for the list of the categories.
+	Categories []string
+}
+
+// contains returns true if the rune belongs to the set.
+func (s CharacterSet) contains(r rune) bool {
+	if strings.ContainsRune(s.Runes, r) {
+		return true
+	}
+
+	for _, category := range s.Categories {
+		if table, ok := unicode.Categories[category]; ok && unicode.Is(table, r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks the configuration of the set.
+func (s CharacterSet) validate() error {
+	if s.Runes == "" && len(s.Categories) == 0 {
+		return fmt.Errorf("the character set is empty, set at least one rune or one Unicode category")
+	}
+
+	for _, category := range s.Categories {
+		if _, ok := unicode.Categories[category]; !ok {
+			return fmt.Errorf("%q is not a Unicode category (Ex: L, Lu, Nd)", category)
+		}
+	}
+
+	return nil
+}
+
+func (s CharacterSet) description(format string) string {
+	var items []string
+	if len(s.Categories) > 0 {
+		categories := make([]string, 0, len(s.Categories))
+		for _, category := range s.Categories {
+			categories = append(categories, fmt.Sprintf(format, category))
+		}
+		items = append(items, fmt.Sprintf("the Unicode categories %s", strings.Join(categories, ", ")))
+	}
+	if s.Runes != "" {
+		items = append(items, fmt.Sprintf("the characters %s", fmt.Sprintf(format, s.Runes)))
+	}
+
+	return strings.Join(items, " and ")
+}
+
+// characterSetPosition is the part of the value checked by a validatorCharacterSet.
+type characterSetPosition string
+
+const (
+	characterSetPositionAll   characterSetPosition = "all"
+	characterSetPositionFirst characterSetPosition = "first"
+	characterSetPositionLast  characterSetPosition = "last"
+)
+
+type validatorCharacterSet struct {
+	set      CharacterSet
+	position characterSetPosition
+	// err is the error returned by the check of the set, done once when the validator is created.
+	err error
+}
+
+func newValidatorCharacterSet(set CharacterSet, position characterSetPosition) *validatorCharacterSet {
+	return &validatorCharacterSet{
+		set:      set,
+		position: position,
+		err:      set.validate(),
+	}
+}
+
+func (validator validatorCharacterSet) description(format string) string {
+	switch validator.position {
+	case characterSetPositionFirst:
+		return "the first character must be one of " + validator.set.description(format)
+	case characterSetPositionLast:
+		return "the last character must be one of " + validator.set.description(format)
+	default:
+		return "allow only " + validator.set.description(format)
+	}
+}
+
+// Description describes the validation in plain text formatting.
+func (validator validatorCharacterSet) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator validatorCharacterSet) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator validatorCharacterSet) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	switch validator.position {
+	case characterSetPositionFirst, characterSetPositionLast:
+		r, _ := utf8.DecodeRuneInString(value)
+		if validator.position == characterSetPositionLast {
+			r, _ = utf8.DecodeLastRuneInString(value)
+		}
+
+		if value == "" || !validator.set.contains(r) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				fmt.Sprintf("the %s character must be one of %s", validator.position, validator.set.description("%s")),
+				fmt.Sprintf("invalid value: %s", value),
+			)
+		}
+	default:
+		for i, r := range []rune(value) {
+			if !validator.set.contains(r) {
+				response.Diagnostics.AddAttributeError(
+					request.Path,
+					fmt.Sprintf("the character %q is not allowed", r),
+					fmt.Sprintf("invalid value: %s, the character %q (%U) at position %d is not one of %s", value, r, r, i, validator.set.description("%s")),
+				)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	cases "github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/cases"
+)
+
+func TestValidCharacterSetValidators(t *testing.T) {
+	t.Parallel()
+
+	objectName := cases.CharacterSet{Runes: cases.ASCIILetters + cases.ASCIIDigits + "-_"}
+	letters := cases.CharacterSet{Runes: cases.ASCIILetters}
+
+	type testCase struct {
+		validator   validator.String
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			validator: cases.AllowedCharacters(objectName),
+			val:       types.StringUnknown(),
+		},
+		"null": {
+			validator: cases.AllowedCharacters(objectName),
+			val:       types.StringNull(),
+		},
+		"allowed-runes": {
+			validator: cases.AllowedCharacters(objectName),
+			val:       types.StringValue("my-object_2"),
+		},
+		"allowed-runes-invalid": {
+			validator:   cases.AllowedCharacters(objectName),
+			val:         types.StringValue("my.object"),
+			expectError: true,
+		},
+		"allowed-categories": {
+			validator: cases.AllowedCharacters(cases.CharacterSet{Categories: []string{"L", "Nd"}, Runes: "-"}),
+			val:       types.StringValue("café-2"),
+		},
+		"allowed-categories-invalid": {
+			validator:   cases.AllowedCharacters(cases.CharacterSet{Categories: []string{"Ll"}}),
+			val:         types.StringValue("Café"),
+			expectError: true,
+		},
+		"allowed-empty-value": {
+			validator: cases.AllowedCharacters(objectName),
+			val:       types.StringValue(""),
+		},
+		"allowed-invalid-category": {
+			validator:   cases.AllowedCharacters(cases.CharacterSet{Categories: []string{"Letter"}}),
+			val:         types.StringValue("value"),
+			expectError: true,
+		},
+		"allowed-empty-set": {
+			validator:   cases.AllowedCharacters(cases.CharacterSet{}),
+			val:         types.StringValue("value"),
+			expectError: true,
+		},
+		"first-character": {
+			validator: cases.FirstCharacter(letters),
+			val:       types.StringValue("a-1"),
+		},
+		"first-character-invalid": {
+			validator:   cases.FirstCharacter(letters),
+			val:         types.StringValue("1-a"),
+			expectError: true,
+		},
+		"first-character-empty": {
+			validator:   cases.FirstCharacter(letters),
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"first-character-unicode": {
+			validator: cases.FirstCharacter(cases.CharacterSet{Categories: []string{"Lu"}}),
+			val:       types.StringValue("Éric"),
+		},
+		"last-character": {
+			validator: cases.LastCharacter(cases.CharacterSet{Runes: cases.ASCIILetters + cases.ASCIIDigits}),
+			val:       types.StringValue("my-object-2"),
+		},
+		"last-character-invalid": {
+			validator:   cases.LastCharacter(cases.CharacterSet{Runes: cases.ASCIILetters + cases.ASCIIDigits}),
+			val:         types.StringValue("my-object-"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidCharacterSetValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	set := cases.CharacterSet{Categories: []string{"L", "Nd"}, Runes: "-_"}
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		validator validator.String
+		want      string
+		wantMD    string
+	}{
+		"allowed": {
+			validator: cases.AllowedCharacters(set),
+			want:      "allow only the Unicode categories L, Nd and the characters -_",
+			wantMD:    "allow only the Unicode categories `L`, `Nd` and the characters `-_`",
+		},
+		"first": {
+			validator: cases.FirstCharacter(cases.CharacterSet{Categories: []string{"L"}}),
+			want:      "the first character must be one of the Unicode categories L",
+			wantMD:    "the first character must be one of the Unicode categories `L`",
+		},
+		"last": {
+			validator: cases.LastCharacter(cases.CharacterSet{Runes: cases.ASCIIDigits}),
+			want:      "the last character must be one of the characters 0123456789",
+			wantMD:    "the last character must be one of the characters `0123456789`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.validator.Description(ctx); got != test.want {
+				t.Errorf("expected description %q, got %q", test.want, got)
+			}
+			if got := test.validator.MarkdownDescription(ctx); got != test.wantMD {
+				t.Errorf("expected markdown description %q, got %q", test.wantMD, got)
+			}
+		})
+	}
+}
@@ -21,11 +21,14 @@ package cases
 import (
 	"context"
 	"fmt"
-	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
 )
 
+var disallowNumberRegex = common.MustCompileRegex(`\d`)
+
 type validatorDisallowNumber struct{}
 
 // Description describes the validation in plain text formatting.
@@ -48,7 +51,7 @@ func (validator validatorDisallowNumber) ValidateString(
 		return
 	}
 
-	if regexp.MustCompile(`\d`).MatchString(request.ConfigValue.ValueString()) {
+	if disallowNumberRegex.MatchString(request.ConfigValue.ValueString()) {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
 			"number characters are not allowed",
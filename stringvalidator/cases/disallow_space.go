@@ -21,11 +21,14 @@ package cases
 import (
 	"context"
 	"fmt"
-	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
 )
 
+var disallowSpaceRegex = common.MustCompileRegex(`\s`)
+
 type validatorDisallowSpace struct{}
 
 // Description describes the validation in plain text formatting.
@@ -48,7 +51,7 @@ func (validator validatorDisallowSpace) ValidateString(
 		return
 	}
 
-	if regexp.MustCompile(`\s`).MatchString(request.ConfigValue.ValueString()) {
+	if disallowSpaceRegex.MatchString(request.ConfigValue.ValueString()) {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
 			"space characters are not allowed",
@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type validatorDisallowSpecial struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator validatorDisallowSpecial) Description(_ context.Context) string {
+	return "disallow special characters"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator validatorDisallowSpecial) MarkdownDescription(_ context.Context) string {
+	return "disallow special characters"
+}
+
+// Validate performs the validation.
+func (validator validatorDisallowSpecial) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, r := range request.ConfigValue.ValueString() {
+		// The special characters are all the characters except the letters, the digits and the spaces.
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"special characters are not allowed",
+				fmt.Sprintf("invalid value: %s", request.ConfigValue.ValueString()),
+			)
+			return
+		}
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+// namingStyle is a naming convention made of words.
+// The words are made of ASCII letters and digits, the value always starts with a letter.
+type namingStyle struct {
+	name        string
+	description string
+	regex       *regexp.Regexp
+	// join builds a value in this style from lowercase words.
+	join func(words []string) string
+}
+
+var (
+	namingStyleSnakeCase = namingStyle{
+		name:        "snake_case",
+		description: "lowercase letters and digits, words separated by a single underscore, the first word must start with a letter, the following words may start with a digit",
+		regex:       common.MustCompileRegex(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+		join:        func(words []string) string { return strings.Join(words, "_") },
+	}
+	namingStyleKebabCase = namingStyle{
+		name:        "kebab-case",
+		description: "lowercase letters and digits, words separated by a single hyphen, the first word must start with a letter, the following words may start with a digit",
+		regex:       common.MustCompileRegex(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+		join:        func(words []string) string { return strings.Join(words, "-") },
+	}
+	namingStyleScreamingSnakeCase = namingStyle{
+		name:        "SCREAMING_SNAKE_CASE",
+		description: "uppercase letters and digits, words separated by a single underscore, the first word must start with a letter, the following words may start with a digit",
+		regex:       common.MustCompileRegex(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`),
+		join:        func(words []string) string { return strings.ToUpper(strings.Join(words, "_")) },
+	}
+	namingStyleDotCase = namingStyle{
+		name:        "dot.case",
+		description: "lowercase letters and digits, words separated by a single dot, the first word must start with a letter, the following words may start with a digit",
+		regex:       common.MustCompileRegex(`^[a-z][a-z0-9]*(\.[a-z0-9]+)*$`),
+		join:        func(words []string) string { return strings.Join(words, ".") },
+	}
+	namingStyleCamelCase = namingStyle{
+		name:        "camelCase",
+		description: "letters and digits, starting with a lowercase letter, each following word starting with an uppercase letter, digits never start a word",
+		regex:       common.MustCompileRegex(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*)*$`),
+		join: func(words []string) string {
+			return words[0] + namingStyleTitle(words[1:])
+		},
+	}
+	namingStylePascalCase = namingStyle{
+		name:        "PascalCase",
+		description: "letters and digits, each word starting with an uppercase letter, digits never start a word",
+		regex:       common.MustCompileRegex(`^([A-Z][a-z0-9]*)+$`),
+		join:        namingStyleTitle,
+	}
+)
+
+// namingStyleTitle concatenates the words with their first letter in uppercase.
+func namingStyleTitle(words []string) string {
+	var b strings.Builder
+	for _, word := range words {
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return b.String()
+}
+
+// namingStyleWords splits a value in lowercase words.
+// The words are separated by the characters other than ASCII letters and digits
+// and by the case changes (Ex: userID is user and id, HTTPServer is http and server).
+// The digits belong to the word they follow.
+func namingStyleWords(value string) []string {
+	var (
+		words []string
+		word  []rune
+	)
+
+	runes := []rune(value)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	for i, r := range runes {
+		if r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r)) {
+			flush()
+			continue
+		}
+
+		if unicode.IsUpper(r) && len(word) > 0 {
+			previous := word[len(word)-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			// A new word starts after a lowercase letter or a digit (userId),
+			// or at the last uppercase letter of an acronym followed by a lowercase letter (HTTPServer).
+			if !unicode.IsUpper(previous) || nextIsLower {
+				flush()
+			}
+		}
+
+		word = append(word, r)
+	}
+	flush()
+
+	return words
+}
+
+// suggest returns the value converted to the style, or an empty string if there is no valid conversion.
+func (s namingStyle) suggest(value string) string {
+	words := namingStyleWords(value)
+	if len(words) == 0 {
+		return ""
+	}
+
+	suggestion := s.join(words)
+	if !s.regex.MatchString(suggestion) {
+		return ""
+	}
+
+	return suggestion
+}
+
+type validatorNamingStyle struct {
+	style namingStyle
+}
+
+// Description describes the validation in plain text formatting.
+func (validator validatorNamingStyle) Description(_ context.Context) string {
+	return fmt.Sprintf("%s (%s)", validator.style.name, validator.style.description)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator validatorNamingStyle) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("`%s` (%s)", validator.style.name, validator.style.description)
+}
+
+// Validate performs the validation.
+func (validator validatorNamingStyle) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if validator.style.regex.MatchString(value) {
+		return
+	}
+
+	detail := fmt.Sprintf("invalid value: %s", value)
+	if suggestion := validator.style.suggest(value); suggestion != "" {
+		detail += fmt.Sprintf(", suggested value: %s", suggestion)
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		fmt.Sprintf("value must be in %s", validator.style.name),
+		detail,
+	)
+}
@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	cases "github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/cases"
+)
+
+func TestValidNamingStyleValidators(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator   func() validator.String
+		val         types.String
+		suggestion  string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			validator: cases.SnakeCase,
+			val:       types.StringUnknown(),
+		},
+		"null": {
+			validator: cases.SnakeCase,
+			val:       types.StringNull(),
+		},
+		"snake-case": {
+			validator: cases.SnakeCase,
+			val:       types.StringValue("my_value"),
+		},
+		"snake-case-digits": {
+			validator: cases.SnakeCase,
+			val:       types.StringValue("ipv4_address_2"),
+		},
+		"snake-case-single-word": {
+			validator: cases.SnakeCase,
+			val:       types.StringValue("value"),
+		},
+		"snake-case-invalid-upper": {
+			validator:   cases.SnakeCase,
+			val:         types.StringValue("myValue"),
+			suggestion:  "my_value",
+			expectError: true,
+		},
+		"snake-case-invalid-leading-digit": {
+			validator:   cases.SnakeCase,
+			val:         types.StringValue("2_value"),
+			expectError: true,
+		},
+		"snake-case-invalid-double-separator": {
+			validator:   cases.SnakeCase,
+			val:         types.StringValue("my__value"),
+			suggestion:  "my_value",
+			expectError: true,
+		},
+		"snake-case-invalid-trailing-separator": {
+			validator:   cases.SnakeCase,
+			val:         types.StringValue("my_value_"),
+			suggestion:  "my_value",
+			expectError: true,
+		},
+		"snake-case-invalid-acronym": {
+			validator:   cases.SnakeCase,
+			val:         types.StringValue("HTTPServer"),
+			suggestion:  "http_server",
+			expectError: true,
+		},
+		"kebab-case": {
+			validator: cases.KebabCase,
+			val:       types.StringValue("my-value-2"),
+		},
+		"kebab-case-invalid-snake": {
+			validator:   cases.KebabCase,
+			val:         types.StringValue("my_value"),
+			suggestion:  "my-value",
+			expectError: true,
+		},
+		"kebab-case-invalid-leading-separator": {
+			validator:   cases.KebabCase,
+			val:         types.StringValue("-my-value"),
+			suggestion:  "my-value",
+			expectError: true,
+		},
+		"camel-case": {
+			validator: cases.CamelCase,
+			val:       types.StringValue("myValue2"),
+		},
+		"camel-case-acronym": {
+			validator: cases.CamelCase,
+			val:       types.StringValue("userID"),
+		},
+		"camel-case-invalid-pascal": {
+			validator:   cases.CamelCase,
+			val:         types.StringValue("MyValue"),
+			suggestion:  "myValue",
+			expectError: true,
+		},
+		"camel-case-invalid-snake": {
+			validator:   cases.CamelCase,
+			val:         types.StringValue("ipv4_address"),
+			suggestion:  "ipv4Address",
+			expectError: true,
+		},
+		"camel-case-invalid-leading-digit": {
+			validator:   cases.CamelCase,
+			val:         types.StringValue("2value"),
+			expectError: true,
+		},
+		"pascal-case": {
+			validator: cases.PascalCase,
+			val:       types.StringValue("MyValue2"),
+		},
+		"pascal-case-invalid-camel": {
+			validator:   cases.PascalCase,
+			val:         types.StringValue("myValue"),
+			suggestion:  "MyValue",
+			expectError: true,
+		},
+		"pascal-case-invalid-separator": {
+			validator:   cases.PascalCase,
+			val:         types.StringValue("My_2"),
+			suggestion:  "My2",
+			expectError: true,
+		},
+		"screaming-snake-case": {
+			validator: cases.ScreamingSnakeCase,
+			val:       types.StringValue("MAX_RETRY_2"),
+		},
+		"screaming-snake-case-invalid-lower": {
+			validator:   cases.ScreamingSnakeCase,
+			val:         types.StringValue("max-retry"),
+			suggestion:  "MAX_RETRY",
+			expectError: true,
+		},
+		"dot-case": {
+			validator: cases.DotCase,
+			val:       types.StringValue("app.config.v2"),
+		},
+		"dot-case-invalid-space": {
+			validator:   cases.DotCase,
+			val:         types.StringValue("App Config"),
+			suggestion:  "app.config",
+			expectError: true,
+		},
+		"dot-case-invalid-unicode": {
+			validator:   cases.DotCase,
+			val:         types.StringValue("café.menu"),
+			suggestion:  "caf.menu",
+			expectError: true,
+		},
+		"invalid-empty": {
+			validator:   cases.KebabCase,
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if !test.expectError {
+				return
+			}
+
+			detail := response.Diagnostics[0].Detail()
+			if test.suggestion == "" && strings.Contains(detail, "suggested value") {
+				t.Fatalf("got unexpected suggestion: %s", detail)
+			}
+			if test.suggestion != "" && !strings.HasSuffix(detail, "suggested value: "+test.suggestion) {
+				t.Fatalf("expected suggestion %q, got %q", test.suggestion, detail)
+			}
+		})
+	}
+}
+
+func TestValidNamingStyleValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := cases.SnakeCase()
+	ctx := context.Background()
+
+	if got, want := v.Description(ctx), "snake_case (lowercase letters and digits, words separated by a single underscore, the first word must start with a letter, the following words may start with a digit)"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "`snake_case` (lowercase letters and digits, words separated by a single underscore, the first word must start with a letter, the following words may start with a digit)"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type validatorNoRepeatedSeparator struct {
+	separators string
+}
+
+func (validator validatorNoRepeatedSeparator) description(format string) string {
+	return fmt.Sprintf("disallow consecutive separators %s", fmt.Sprintf(format, validator.separators))
+}
+
+// Description describes the validation in plain text formatting.
+func (validator validatorNoRepeatedSeparator) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator validatorNoRepeatedSeparator) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator validatorNoRepeatedSeparator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.separators == "" {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			"the list of separators is empty",
+		)
+		return
+	}
+
+	var previous rune
+	for i, r := range []rune(request.ConfigValue.ValueString()) {
+		isSeparator := strings.ContainsRune(validator.separators, r)
+		if isSeparator && i > 0 && strings.ContainsRune(validator.separators, previous) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"consecutive separators are not allowed",
+				fmt.Sprintf("invalid value: %s, the separators %q at position %d follow each other", request.ConfigValue.ValueString(), string([]rune{previous, r}), i-1),
+			)
+			return
+		}
+		previous = r
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package cases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	cases "github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/cases"
+)
+
+func TestValidNoRepeatedSeparatorValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		separators  string
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			separators: "-",
+			val:        types.StringUnknown(),
+		},
+		"null": {
+			separators: "-",
+			val:        types.StringNull(),
+		},
+		"valid": {
+			separators: "-",
+			val:        types.StringValue("my-object-name"),
+		},
+		"valid-other-separator": {
+			separators: "-",
+			val:        types.StringValue("my__object"),
+		},
+		"invalid-repeated": {
+			separators:  "-",
+			val:         types.StringValue("my--object"),
+			expectError: true,
+		},
+		"invalid-mixed": {
+			separators:  "-_",
+			val:         types.StringValue("my-_object"),
+			expectError: true,
+		},
+		"invalid-configuration": {
+			separators:  "",
+			val:         types.StringValue("my-object"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			cases.NoRepeatedSeparator(test.separators).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
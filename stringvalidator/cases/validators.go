@@ -35,3 +35,60 @@ func DisallowNumber() validator.String {
 func DisallowSpace() validator.String {
 	return &validatorDisallowSpace{}
 }
+
+// SnakeCase validates that the string is in snake_case (Ex: my_value_2).
+func SnakeCase() validator.String {
+	return &validatorNamingStyle{style: namingStyleSnakeCase}
+}
+
+// KebabCase validates that the string is in kebab-case (Ex: my-value-2).
+func KebabCase() validator.String {
+	return &validatorNamingStyle{style: namingStyleKebabCase}
+}
+
+// CamelCase validates that the string is in camelCase (Ex: myValue2).
+func CamelCase() validator.String {
+	return &validatorNamingStyle{style: namingStyleCamelCase}
+}
+
+// PascalCase validates that the string is in PascalCase (Ex: MyValue2).
+func PascalCase() validator.String {
+	return &validatorNamingStyle{style: namingStylePascalCase}
+}
+
+// ScreamingSnakeCase validates that the string is in SCREAMING_SNAKE_CASE (Ex: MY_VALUE_2).
+func ScreamingSnakeCase() validator.String {
+	return &validatorNamingStyle{style: namingStyleScreamingSnakeCase}
+}
+
+// DotCase validates that the string is in dot.case (Ex: my.value.2).
+func DotCase() validator.String {
+	return &validatorNamingStyle{style: namingStyleDotCase}
+}
+
+// DisallowSpecial validates that the string contains only letters, digits and spaces.
+func DisallowSpecial() validator.String {
+	return &validatorDisallowSpecial{}
+}
+
+// AllowedCharacters validates that all the characters of the string belong to the set.
+func AllowedCharacters(set CharacterSet) validator.String {
+	return newValidatorCharacterSet(set, characterSetPositionAll)
+}
+
+// FirstCharacter validates that the first character of the string belongs to the set.
+// An empty string is rejected.
+func FirstCharacter(set CharacterSet) validator.String {
+	return newValidatorCharacterSet(set, characterSetPositionFirst)
+}
+
+// LastCharacter validates that the last character of the string belongs to the set.
+// An empty string is rejected.
+func LastCharacter(set CharacterSet) validator.String {
+	return newValidatorCharacterSet(set, characterSetPositionLast)
+}
+
+// NoRepeatedSeparator validates that two separators never follow each other (Ex: a--b or a-_b with the separators "-_").
+func NoRepeatedSeparator(separators string) validator.String {
+	return &validatorNoRepeatedSeparator{separators: separators}
+}
@@ -26,13 +26,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+	casesTypes "github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/cases"
 )
 
 func TestCasesValidator(t *testing.T) {
 	t.Parallel()
 
+	objectName := casesTypes.CharacterSet{Runes: casesTypes.ASCIILetters + casesTypes.ASCIIDigits + "-_"}
+	objectNameRules := []casesTypes.Validator{
+		casesTypes.AllowedCharacters(objectName),
+		casesTypes.FirstCharacter(casesTypes.CharacterSet{Runes: casesTypes.ASCIILetters}),
+		casesTypes.LastCharacter(casesTypes.CharacterSet{Runes: casesTypes.ASCIILetters + casesTypes.ASCIIDigits + "_"}),
+		casesTypes.NoRepeatedSeparator("-"),
+	}
+
 	type testCase struct {
 		typesOfCases []stringvalidator.CasesValidatorType
+		rules        []casesTypes.Validator
 		val          types.String
 		ComparatorOR bool
 		expectError  bool
@@ -78,6 +88,86 @@ func TestCasesValidator(t *testing.T) {
 			typesOfCases: []stringvalidator.CasesValidatorType{},
 			expectError:  true,
 		},
+		"snake-case": {
+			val: types.StringValue("my_value_2"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesSnakeCase,
+			},
+		},
+		"snake-case-invalid": {
+			val: types.StringValue("myValue"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesSnakeCase,
+			},
+			expectError: true,
+		},
+		"kebab-case-and-disallow-number": {
+			val: types.StringValue("my-value-2"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesKebabCase,
+				stringvalidator.CasesDisallowNumber,
+			},
+			expectError: true,
+		},
+		"camel-case": {
+			val: types.StringValue("myValue"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesCamelCase,
+			},
+		},
+		"pascal-case": {
+			val: types.StringValue("MyValue"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesPascalCase,
+			},
+		},
+		"screaming-snake-case": {
+			val: types.StringValue("MY_VALUE"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesScreamingSnakeCase,
+			},
+		},
+		"dot-case-invalid": {
+			val: types.StringValue("my.Value"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesDotCase,
+			},
+			expectError: true,
+		},
+		"disallow-special": {
+			val: types.StringValue("my-value"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesDisallowSpecial,
+			},
+			expectError: true,
+		},
+		"rules-only": {
+			val:   types.StringValue("My-object_2"),
+			rules: objectNameRules,
+		},
+		"rules-invalid-first-character": {
+			val:         types.StringValue("2-object"),
+			rules:       objectNameRules,
+			expectError: true,
+		},
+		"rules-invalid-last-character": {
+			val:         types.StringValue("my-object-"),
+			rules:       objectNameRules,
+			expectError: true,
+		},
+		"rules-invalid-repeated-separator": {
+			val:         types.StringValue("my--object"),
+			rules:       objectNameRules,
+			expectError: true,
+		},
+		"rules-and-cases": {
+			val: types.StringValue("My-object"),
+			typesOfCases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesDisallowUpper,
+			},
+			rules:       objectNameRules,
+			expectError: true,
+		},
 		"invalid-validator": {
 			val: types.StringValue("lowerAndUPPER"),
 			typesOfCases: []stringvalidator.CasesValidatorType{
@@ -94,7 +184,7 @@ func TestCasesValidator(t *testing.T) {
 				ConfigValue: test.val,
 			}
 			response := validator.StringResponse{}
-			stringvalidator.Cases(test.typesOfCases).ValidateString(context.TODO(), request, &response)
+			stringvalidator.Cases(test.typesOfCases, test.rules...).ValidateString(context.TODO(), request, &response)
 
 			if !response.Diagnostics.HasError() && test.expectError {
 				t.Fatal("expected error, got no error")
@@ -113,6 +203,7 @@ func TestCasesValidatorDescription(t *testing.T) {
 	type testCase struct {
 		description string
 		cases       []stringvalidator.CasesValidatorType
+		rules       []casesTypes.Validator
 	}
 	tests := map[string]testCase{
 		"disallow-upper": {
@@ -134,16 +225,32 @@ func TestCasesValidatorDescription(t *testing.T) {
 				stringvalidator.CasesDisallowNumber,
 			},
 		},
+		"disallow-upper-and-rule": {
+			description: "The value must respect the following rules : disallow uppercase characters, disallow consecutive separators -",
+			cases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesDisallowUpper,
+			},
+			rules: []casesTypes.Validator{
+				casesTypes.NoRepeatedSeparator("-"),
+			},
+		},
 		"no-validator": {
 			description: "invalid configuration",
 			cases:       []stringvalidator.CasesValidatorType{},
 		},
+		"unknown-validator": {
+			description: "invalid configuration",
+			cases: []stringvalidator.CasesValidatorType{
+				stringvalidator.CasesDisallowUpper,
+				"invalid",
+			},
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			validator := stringvalidator.Cases(test.cases)
+			validator := stringvalidator.Cases(test.cases, test.rules...)
 			if validator.Description(context.Background()) != test.description {
 				t.Fatalf("got unexpected description: %s != %s", validator.Description(context.Background()), test.description)
 			}
@@ -0,0 +1,270 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = certificate{}
+
+// CertificateType is the expected type of a certificate.
+type CertificateType string
+
+const (
+	// CertificateTypeAny accepts any certificate.
+	CertificateTypeAny CertificateType = ""
+	// CertificateTypeCA requires a certificate authority (basic constraints CA:TRUE).
+	CertificateTypeCA CertificateType = "ca"
+	// CertificateTypeLeaf requires an end-entity certificate (not a certificate authority).
+	CertificateTypeLeaf CertificateType = "leaf"
+)
+
+// certificateExtKeyUsageNames are the names of the extended key usages defined by RFC 5280 section 4.2.1.12.
+var certificateExtKeyUsageNames = map[x509.ExtKeyUsage]string{
+	x509.ExtKeyUsageAny:             "any",
+	x509.ExtKeyUsageServerAuth:      "serverAuth",
+	x509.ExtKeyUsageClientAuth:      "clientAuth",
+	x509.ExtKeyUsageCodeSigning:     "codeSigning",
+	x509.ExtKeyUsageEmailProtection: "emailProtection",
+	x509.ExtKeyUsageIPSECEndSystem:  "ipsecEndSystem",
+	x509.ExtKeyUsageIPSECTunnel:     "ipsecTunnel",
+	x509.ExtKeyUsageIPSECUser:       "ipsecUser",
+	x509.ExtKeyUsageTimeStamping:    "timeStamping",
+	x509.ExtKeyUsageOCSPSigning:     "OCSPSigning",
+}
+
+func certificateExtKeyUsageName(usage x509.ExtKeyUsage) string {
+	if name, ok := certificateExtKeyUsageNames[usage]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("extKeyUsage(%d)", usage)
+}
+
+type CertificateParams struct {
+	// Clock returns the current time used to check the validity period.
+	// If nil, time.Now is used.
+	Clock func() time.Time
+	// MinRemainingValidity is the minimum remaining validity of the certificates (Ex: 30 * 24 * time.Hour).
+	// If 0, the certificates must only be valid at the time of the validation.
+	MinRemainingValidity time.Duration
+	// KeyAlgorithms is the list of allowed public key algorithms (Ex: x509.RSA, x509.ECDSA).
+	// If empty, all the algorithms are allowed.
+	KeyAlgorithms []x509.PublicKeyAlgorithm
+	// MinRSAKeySize is the minimum modulus size in bits of the RSA public keys (Ex: 2048). Ignored if 0.
+	MinRSAKeySize int
+	// MinECDSAKeySize is the minimum curve size in bits of the ECDSA public keys (Ex: 384). Ignored if 0.
+	MinECDSAKeySize int
+	// ExtKeyUsages is the list of the extended key usages required on the first certificate (Ex: x509.ExtKeyUsageServerAuth).
+	// A certificate with the "any" extended key usage satisfies all of them.
+	ExtKeyUsages []x509.ExtKeyUsage
+	// Type is the expected type of the first certificate (CertificateTypeCA or CertificateTypeLeaf).
+	// If empty, any type is accepted.
+	Type CertificateType
+}
+
+type certificate struct {
+	params CertificateParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator certificate) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator certificate) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+func (validator certificate) description(format string) string {
+	constraints := []string{"currently valid"}
+
+	if validator.params.MinRemainingValidity > 0 {
+		constraints = append(constraints, fmt.Sprintf("valid for at least %s", internal.FormatDuration(validator.params.MinRemainingValidity)))
+	}
+
+	if len(validator.params.KeyAlgorithms) > 0 {
+		algorithms := make([]string, 0, len(validator.params.KeyAlgorithms))
+		for _, algorithm := range validator.params.KeyAlgorithms {
+			algorithms = append(algorithms, fmt.Sprintf(format, algorithm))
+		}
+		constraints = append(constraints, fmt.Sprintf("key algorithm %s", strings.Join(algorithms, " or ")))
+	}
+
+	if validator.params.MinRSAKeySize > 0 {
+		constraints = append(constraints, fmt.Sprintf("RSA key size of at least %d bits", validator.params.MinRSAKeySize))
+	}
+
+	if validator.params.MinECDSAKeySize > 0 {
+		constraints = append(constraints, fmt.Sprintf("ECDSA key size of at least %d bits", validator.params.MinECDSAKeySize))
+	}
+
+	if len(validator.params.ExtKeyUsages) > 0 {
+		usages := make([]string, 0, len(validator.params.ExtKeyUsages))
+		for _, usage := range validator.params.ExtKeyUsages {
+			usages = append(usages, fmt.Sprintf(format, certificateExtKeyUsageName(usage)))
+		}
+		constraints = append(constraints, fmt.Sprintf("extended key usages %s", strings.Join(usages, ", ")))
+	}
+
+	switch validator.params.Type {
+	case CertificateTypeCA:
+		constraints = append(constraints, "certificate authority")
+	case CertificateTypeLeaf:
+		constraints = append(constraints, "leaf certificate")
+	}
+
+	return fmt.Sprintf("The value must be one or more PEM encoded X.509 certificates. Enforced constraints: %s", strings.Join(constraints, ", "))
+}
+
+func (validator certificate) now() time.Time {
+	if validator.params.Clock == nil {
+		return time.Now()
+	}
+
+	return validator.params.Clock()
+}
+
+// Validate performs the validation.
+func (validator certificate) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	certificates, err := internal.ParsePEMCertificates(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse certificate",
+			err.Error(),
+		)
+		return
+	}
+
+	now := validator.now()
+
+	for i, c := range certificates {
+		for _, err := range validator.validateCertificate(c, now) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid certificate",
+				fmt.Sprintf("Certificate %d (%s): %s", i+1, c.Subject, err),
+			)
+		}
+	}
+
+	// The type and the usages are checked on the first certificate, the leaf of a chain.
+	for _, err := range validator.validateFirstCertificate(certificates[0]) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid certificate",
+			fmt.Sprintf("Certificate 1 (%s): %s", certificates[0].Subject, err),
+		)
+	}
+}
+
+// validateCertificate checks the constraints applied to every certificate of the value.
+func (validator certificate) validateCertificate(c *x509.Certificate, now time.Time) (errs []error) {
+	switch {
+	case now.Before(c.NotBefore):
+		errs = append(errs, fmt.Errorf("the certificate is not valid before %s", c.NotBefore.UTC().Format(time.RFC3339)))
+	case now.After(c.NotAfter):
+		errs = append(errs, fmt.Errorf("the certificate expired on %s", c.NotAfter.UTC().Format(time.RFC3339)))
+	case validator.params.MinRemainingValidity > 0 && c.NotAfter.Sub(now) < validator.params.MinRemainingValidity:
+		errs = append(errs, fmt.Errorf("the certificate expires on %s, expected a validity of at least %s", c.NotAfter.UTC().Format(time.RFC3339), internal.FormatDuration(validator.params.MinRemainingValidity)))
+	}
+
+	if len(validator.params.KeyAlgorithms) > 0 {
+		allowed := false
+		for _, algorithm := range validator.params.KeyAlgorithms {
+			if c.PublicKeyAlgorithm == algorithm {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			errs = append(errs, fmt.Errorf("the key algorithm %s is not allowed", c.PublicKeyAlgorithm))
+		}
+	}
+
+	// Ed25519 keys have a fixed size.
+	var minKeySize int
+	switch c.PublicKeyAlgorithm {
+	case x509.RSA:
+		minKeySize = validator.params.MinRSAKeySize
+	case x509.ECDSA:
+		minKeySize = validator.params.MinECDSAKeySize
+	}
+
+	if size := internal.PublicKeySize(c.PublicKey); size < minKeySize {
+		errs = append(errs, fmt.Errorf("the %s key size is %d bits, expected at least %d bits", c.PublicKeyAlgorithm, size, minKeySize))
+	}
+
+	return errs
+}
+
+// validateFirstCertificate checks the constraints applied to the first certificate of the value.
+func (validator certificate) validateFirstCertificate(c *x509.Certificate) (errs []error) {
+	isCA := c.BasicConstraintsValid && c.IsCA
+
+	switch {
+	case validator.params.Type == CertificateTypeCA && !isCA:
+		errs = append(errs, fmt.Errorf("the certificate is not a certificate authority"))
+	case validator.params.Type == CertificateTypeLeaf && isCA:
+		errs = append(errs, fmt.Errorf("the certificate is a certificate authority, expected a leaf certificate"))
+	}
+
+	for _, required := range validator.params.ExtKeyUsages {
+		found := false
+		for _, usage := range c.ExtKeyUsage {
+			if usage == required || usage == x509.ExtKeyUsageAny {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errs = append(errs, fmt.Errorf("the extended key usage %s is missing", certificateExtKeyUsageName(required)))
+		}
+	}
+
+	return errs
+}
+
+// Certificate validates that a string holds one or more PEM encoded X.509 certificates.
+//
+// Every certificate must be valid at the time of the validation and respect the key constraints.
+// The type and the extended key usages are checked on the first certificate (the leaf of a chain).
+//
+// Parameters:
+//   - settings: CertificateParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string holds valid certificates.
+func Certificate(settings CertificateParams) validator.String {
+	return &certificate{
+		params: settings,
+	}
+}
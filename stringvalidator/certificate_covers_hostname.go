@@ -0,0 +1,27 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+// CertificateCoversHostname checks that the subject alternative names of the PEM encoded
+// certificate held by the path.Expression attribute cover the hostname of this attribute.
+// The DNS names may contain a wildcard (Ex: *.example.com covers www.example.com).
+// The check is skipped if the hostname or the certificate is null or unknown.
+func CertificateCoversHostname(path path.Expression) validator.String {
+	return internal.CertificateCoversHostname{
+		PathExpression: path,
+	}
+}
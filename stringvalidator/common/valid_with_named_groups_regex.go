@@ -0,0 +1,241 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = NamedGroupsRegexValidator{}
+
+// RegexGroup contains the validators of the value captured by a named group.
+type RegexGroup struct {
+	// String validates the captured value as a string.
+	String []validator.String
+	// Int64 parses the captured value as a base 10 integer and validates it
+	// (Ex: int64validator.Between(1, 999)). A value that is not an integer is invalid.
+	Int64 []validator.Int64
+}
+
+// NamedGroupsRegexValidator validates that the value matches the regular expression Regex,
+// and that the values captured by its named groups (Ex: `vm-(?P<index>\d+)`) are valid.
+// Use NewNamedGroupsRegexValidator to compile the regular expression once, at construction.
+//
+// The captured values are interpolated in ErrorSummary and ErrorDetail with {name}
+// (Ex: "The VM index {index} is out of range"). The groups that did not capture
+// a value are interpolated as an empty string.
+type NamedGroupsRegexValidator struct {
+	Desc  string
+	Regex string
+
+	ErrorSummary string
+	ErrorDetail  string
+
+	// Groups contains the validators of the values captured by the named groups, by group name.
+	// The value matches only if all the captured values are valid. The groups that did not
+	// capture a value (Ex: an optional group) are skipped.
+	Groups map[string]RegexGroup
+	// Negate inverts the validation: the value must not match Regex and its Groups.
+	Negate bool
+
+	// re is the compiled Regex. If nil (Ex: a NamedGroupsRegexValidator literal), the regular
+	// expression is compiled at validation.
+	re *regexp.Regexp
+}
+
+// NewNamedGroupsRegexValidator returns a copy of the settings with the regular expression compiled.
+// An error is returned if the regular expression is invalid, or if Groups references
+// a group that is not a named group of the regular expression.
+func NewNamedGroupsRegexValidator(settings NamedGroupsRegexValidator) (NamedGroupsRegexValidator, error) {
+	settings.re = nil
+	re, err := settings.compile()
+	if err != nil {
+		return NamedGroupsRegexValidator{}, err
+	}
+
+	settings.re = re
+	return settings, nil
+}
+
+// Description describes the validation in plain text formatting.
+func (validator NamedGroupsRegexValidator) Description(_ context.Context) string {
+	return validator.Desc
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator NamedGroupsRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// compile returns the compiled regular expression and checks the groups.
+func (validator NamedGroupsRegexValidator) compile() (*regexp.Regexp, error) {
+	if validator.re != nil {
+		return validator.re, nil
+	}
+
+	re, err := regexp.Compile(validator.Regex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", validator.Regex, err)
+	}
+
+	for name, group := range validator.Groups {
+		if name == "" || re.SubexpIndex(name) < 0 {
+			return nil, fmt.Errorf("the group %q is not a named group of the regular expression %q", name, validator.Regex)
+		}
+		if len(group.String) == 0 && len(group.Int64) == 0 {
+			return nil, fmt.Errorf("the group %q has no validator", name)
+		}
+	}
+
+	return re, nil
+}
+
+// Validate performs the validation.
+func (validator NamedGroupsRegexValidator) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	re, err := validator.compile()
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	captures, matched := captureRegexGroups(re, request.ConfigValue.ValueString())
+	groupErr := ""
+	if matched {
+		groupErr = validateRegexGroups(ctx, request, re, captures, validator.Groups)
+	}
+
+	replacer := regexGroupsReplacer(re, captures)
+	summary := replacer.Replace(validator.ErrorSummary)
+	detail := replacer.Replace(validator.ErrorDetail)
+
+	switch {
+	case validator.Negate:
+		if matched && groupErr == "" {
+			response.Diagnostics.AddAttributeError(request.Path, summary, detail)
+		}
+	case !matched:
+		response.Diagnostics.AddAttributeError(request.Path, summary, detail)
+	case groupErr != "":
+		if detail != "" {
+			detail += ": "
+		}
+		response.Diagnostics.AddAttributeError(request.Path, summary, detail+groupErr)
+	}
+}
+
+// regexCapture is the value captured by a named group.
+type regexCapture struct {
+	value string
+	// ok is false if the group did not capture a value (Ex: an optional group).
+	ok bool
+}
+
+// captureRegexGroups returns the values captured by the named groups of the first match.
+func captureRegexGroups(re *regexp.Regexp, value string) (map[string]regexCapture, bool) {
+	names := re.SubexpNames()
+	captures := make(map[string]regexCapture, len(names))
+
+	indexes := re.FindStringSubmatchIndex(value)
+	for i, name := range names {
+		if name == "" {
+			continue
+		}
+		if indexes == nil || indexes[2*i] < 0 {
+			captures[name] = regexCapture{}
+			continue
+		}
+		captures[name] = regexCapture{value: value[indexes[2*i]:indexes[2*i+1]], ok: true}
+	}
+
+	return captures, indexes != nil
+}
+
+// validateRegexGroups validates the captured values in the order of the groups in the
+// regular expression, and returns the description of the first invalid value.
+func validateRegexGroups(ctx context.Context, request validator.StringRequest, re *regexp.Regexp, captures map[string]regexCapture, groups map[string]RegexGroup) string {
+	for _, name := range re.SubexpNames() {
+		group, ok := groups[name]
+		if !ok || name == "" || !captures[name].ok {
+			continue
+		}
+		value := captures[name].value
+
+		for _, v := range group.String {
+			response := validator.StringResponse{}
+			v.ValidateString(ctx, validator.StringRequest{
+				Path:           request.Path,
+				PathExpression: request.PathExpression,
+				Config:         request.Config,
+				ConfigValue:    types.StringValue(value),
+			}, &response)
+			if response.Diagnostics.HasError() {
+				diag := response.Diagnostics.Errors()[0]
+				return fmt.Sprintf("the value %q captured by the group %q is invalid: %s: %s", value, name, diag.Summary(), diag.Detail())
+			}
+		}
+
+		if len(group.Int64) == 0 {
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Sprintf("the value %q captured by the group %q is not an integer", value, name)
+		}
+
+		for _, v := range group.Int64 {
+			response := validator.Int64Response{}
+			v.ValidateInt64(ctx, validator.Int64Request{
+				Path:           request.Path,
+				PathExpression: request.PathExpression,
+				Config:         request.Config,
+				ConfigValue:    types.Int64Value(n),
+			}, &response)
+			if response.Diagnostics.HasError() {
+				diag := response.Diagnostics.Errors()[0]
+				return fmt.Sprintf("the value %q captured by the group %q is invalid: %s: %s", value, name, diag.Summary(), diag.Detail())
+			}
+		}
+	}
+
+	return ""
+}
+
+// regexGroupsReplacer returns a replacer of the {name} placeholders by the captured values.
+// The placeholders are replaced in a single pass, a captured value is never replaced again.
+func regexGroupsReplacer(re *regexp.Regexp, captures map[string]regexCapture) *strings.Replacer {
+	pairs := make([]string, 0, 2*len(captures))
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			pairs = append(pairs, "{"+name+"}", captures[name].value)
+		}
+	}
+
+	return strings.NewReplacer(pairs...)
+}
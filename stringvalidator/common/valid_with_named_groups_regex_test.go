@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	hstringvalidator "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+func TestValidNamedGroupsRegexValidator(t *testing.T) {
+	t.Parallel()
+
+	vmName := common.NamedGroupsRegexValidator{
+		Regex:        `^(?P<env>[a-z]+)-vm-(?P<index>\d+)$`,
+		ErrorSummary: "Invalid VM name {env}-{index}",
+		ErrorDetail:  "The VM name must be <env>-vm-<index>",
+		Groups: map[string]common.RegexGroup{
+			"env":   {String: []validator.String{hstringvalidator.OneOf("dev", "prod")}},
+			"index": {Int64: []validator.Int64{int64validator.Between(1, 999)}},
+		},
+	}
+
+	negated := vmName
+	negated.Negate = true
+	negated.ErrorSummary = "Reserved VM name"
+	negated.ErrorDetail = "The VM {index} of {env} is reserved"
+
+	type testCase struct {
+		val         types.String
+		validator   common.NamedGroupsRegexValidator
+		expectError bool
+		wantSummary string
+		wantDetail  string
+	}
+	tests := map[string]testCase{
+		"valid": {
+			val:       types.StringValue("prod-vm-42"),
+			validator: vmName,
+		},
+		"no-match": {
+			val:         types.StringValue("prod-42"),
+			validator:   vmName,
+			expectError: true,
+			wantSummary: "Invalid VM name -",
+			wantDetail:  "The VM name must be <env>-vm-<index>",
+		},
+		"invalid-string-group": {
+			val:         types.StringValue("test-vm-42"),
+			validator:   vmName,
+			expectError: true,
+			wantSummary: "Invalid VM name test-42",
+		},
+		"invalid-int64-group": {
+			val:         types.StringValue("prod-vm-1000"),
+			validator:   vmName,
+			expectError: true,
+			wantSummary: "Invalid VM name prod-1000",
+		},
+		"int64-group-overflow": {
+			val:         types.StringValue("prod-vm-99999999999999999999"),
+			validator:   vmName,
+			expectError: true,
+			wantDetail:  `The VM name must be <env>-vm-<index>: the value "99999999999999999999" captured by the group "index" is not an integer`,
+		},
+		"negate": {
+			val:       types.StringValue("prod-vm-1000"),
+			validator: negated,
+		},
+		"negate-no-match": {
+			val:       types.StringValue("prod-42"),
+			validator: negated,
+		},
+		"negate-invalid": {
+			val:         types.StringValue("dev-vm-7"),
+			validator:   negated,
+			expectError: true,
+			wantSummary: "Reserved VM name",
+			wantDetail:  "The VM 7 of dev is reserved",
+		},
+		"negate-without-group": {
+			val:         types.StringValue("admin"),
+			validator:   common.NamedGroupsRegexValidator{Regex: `^admin$`, Negate: true},
+			expectError: true,
+		},
+		"optional-group": {
+			val: types.StringValue("vm"),
+			validator: common.NamedGroupsRegexValidator{
+				Regex:  `^vm(-(?P<index>\d+))?$`,
+				Groups: map[string]common.RegexGroup{"index": {Int64: []validator.Int64{int64validator.AtLeast(1)}}},
+			},
+		},
+		"captured-placeholder": {
+			val: types.StringValue("{b}-5"),
+			validator: common.NamedGroupsRegexValidator{
+				Regex:        `^(?P<a>.*)-(?P<b>\d+)$`,
+				ErrorSummary: "a={a} b={b}",
+				Negate:       true,
+			},
+			expectError: true,
+			wantSummary: "a={b} b=5",
+		},
+		"unknown-group": {
+			val: types.StringValue("vm-1"),
+			validator: common.NamedGroupsRegexValidator{
+				Regex:  `^vm-(?P<index>\d+)$`,
+				Groups: map[string]common.RegexGroup{"idx": {Int64: []validator.Int64{int64validator.AtLeast(1)}}},
+			},
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantSummary != "" && response.Diagnostics[0].Summary() != test.wantSummary {
+				t.Fatalf("expected summary %q, got %q", test.wantSummary, response.Diagnostics[0].Summary())
+			}
+
+			if test.wantDetail != "" && response.Diagnostics[0].Detail() != test.wantDetail {
+				t.Fatalf("expected detail %q, got %q", test.wantDetail, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestNewNamedGroupsRegexValidatorInvalidConfiguration(t *testing.T) {
+	t.Parallel()
+
+	if _, err := common.NewNamedGroupsRegexValidator(common.NamedGroupsRegexValidator{Regex: `[a-`}); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+
+	if _, err := common.NewNamedGroupsRegexValidator(common.NamedGroupsRegexValidator{
+		Regex:  `^vm-(?P<index>\d+)$`,
+		Groups: map[string]common.RegexGroup{"index": {}},
+	}); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
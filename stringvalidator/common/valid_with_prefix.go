@@ -23,36 +23,189 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ validator.String = PrefixValidator{}
 
+// PrefixMode is where the prefix must be found in the value.
+type PrefixMode string
+
+const (
+	// PrefixModePrefix checks that the value starts with the prefix.
+	PrefixModePrefix PrefixMode = ""
+	// PrefixModeSuffix checks that the value ends with the prefix.
+	PrefixModeSuffix PrefixMode = "suffix"
+	// PrefixModeContains checks that the value contains the prefix.
+	PrefixModeContains PrefixMode = "contains"
+)
+
+type prefixModeText struct {
+	verb    string
+	noun    string
+	nouns   string
+	summary string
+	test    func(value, prefix string) bool
+}
+
+var prefixModes = map[PrefixMode]prefixModeText{
+	PrefixModePrefix:   {verb: "start with", noun: "prefix", nouns: "prefixes", summary: "Does not start with prefix", test: strings.HasPrefix},
+	PrefixModeSuffix:   {verb: "end with", noun: "suffix", nouns: "suffixes", summary: "Does not end with suffix", test: strings.HasSuffix},
+	PrefixModeContains: {verb: "contain", noun: "substring", nouns: "substrings", summary: "Does not contain substring", test: strings.Contains},
+}
+
 type PrefixValidator struct {
 	Prefix string
+
+	// Prefixes are alternatives to Prefix: the value is valid if it matches any of them.
+	Prefixes []string
+	// PrefixPath is the path of a string attribute whose value is an alternative to Prefix
+	// (Ex: the names of the children must start with the name of the parent).
+	// The attribute is skipped if it is null or unknown.
+	PrefixPath path.Expression
+	// Mode is where the prefix must be found in the value. Default is PrefixModePrefix.
+	Mode PrefixMode
+	// CaseInsensitive compares the value and the prefixes regardless of the case.
+	CaseInsensitive bool
+}
+
+func (validator PrefixValidator) hasPrefixPath() bool {
+	return !validator.PrefixPath.Equal(path.Expression{})
+}
+
+// prefixes returns the literal alternatives. Prefix is an alternative if it is set,
+// or if it is the only one (Ex: PrefixContains("") matches any value).
+func (validator PrefixValidator) prefixes() []string {
+	if validator.Prefix == "" && (len(validator.Prefixes) > 0 || validator.hasPrefixPath()) {
+		return validator.Prefixes
+	}
+
+	return append([]string{validator.Prefix}, validator.Prefixes...)
+}
+
+// description returns the description of the alternatives, each literal formatted with format (Ex: "`%s`").
+func (validator PrefixValidator) description(format, prefixPath string) string {
+	alternatives := make([]string, 0, len(validator.Prefixes)+2)
+	for _, prefix := range validator.prefixes() {
+		alternatives = append(alternatives, fmt.Sprintf(format, prefix))
+	}
+	if validator.hasPrefixPath() {
+		alternatives = append(alternatives, "the value of the "+prefixPath+" attribute")
+	}
+
+	description := prefixModes[validator.Mode].verb + " "
+	if len(alternatives) > 1 {
+		description += "one of " + strings.Join(alternatives[:len(alternatives)-1], ", ") + " or " + alternatives[len(alternatives)-1]
+	} else {
+		description += strings.Join(alternatives, "")
+	}
+
+	if validator.CaseInsensitive {
+		description += " (case-insensitive)"
+	}
+
+	return description
 }
 
 // Description describes the validation in plain text formatting.
 func (validator PrefixValidator) Description(_ context.Context) string {
-	return fmt.Sprintf("must start with \"%s\"", validator.Prefix)
+	return "must " + validator.description("\"%s\"", validator.PrefixPath.String())
 }
 
 // MarkdownDescription describes the validation in Markdown formatting.
 func (validator PrefixValidator) MarkdownDescription(_ context.Context) string {
-	return fmt.Sprintf("This value must start with `%s`.", validator.Prefix)
+	return "This value must " + validator.description("`%s`", fmt.Sprintf("[`%s`](#%s)", validator.PrefixPath, validator.PrefixPath)) + "."
 }
 
 // Validate performs the validation.
-func (validator PrefixValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+func (validator PrefixValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
 	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
 		return
 	}
 
-	if !strings.HasPrefix(request.ConfigValue.ValueString(), validator.Prefix) {
+	mode, ok := prefixModes[validator.Mode]
+	if !ok {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
-			"Does not start with prefix",
-			fmt.Sprintf("The value %s does not start with the prefix \"%s\".", request.ConfigValue.String(), validator.Prefix),
+			"Invalid validator configuration",
+			fmt.Sprintf("unknown prefix mode %q (expected suffix or contains)", validator.Mode),
 		)
+		return
+	}
+
+	prefixes := validator.prefixes()
+	if validator.hasPrefixPath() {
+		paths, diags := request.Config.PathMatches(ctx, request.PathExpression.Merge(validator.PrefixPath))
+		response.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		for _, p := range paths {
+			var prefixValue attr.Value
+			diags = request.Config.GetAttribute(ctx, p, &prefixValue)
+			if diags.HasError() {
+				response.Diagnostics.AddError(
+					fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+					fmt.Sprintf("Unable to retrieve attribute path: %q", p),
+				)
+				return
+			}
+
+			// The prefix is checked when it is known.
+			if prefixValue.IsNull() || prefixValue.IsUnknown() {
+				continue
+			}
+
+			prefixString, ok := prefixValue.(types.String)
+			if !ok {
+				response.Diagnostics.AddError(
+					fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+					fmt.Sprintf("The attribute %s is not a string", p),
+				)
+				return
+			}
+
+			prefixes = append(prefixes, prefixString.ValueString())
+		}
+
+		// Without a literal prefix, there is nothing to check until the attribute is known.
+		if len(prefixes) == 0 {
+			return
+		}
+	}
+
+	value := request.ConfigValue.ValueString()
+	for _, prefix := range prefixes {
+		if validator.CaseInsensitive {
+			if mode.test(strings.ToLower(value), strings.ToLower(prefix)) {
+				return
+			}
+		} else if mode.test(value, prefix) {
+			return
+		}
+	}
+
+	quoted := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		quoted = append(quoted, fmt.Sprintf("\"%s\"", prefix))
 	}
+
+	detail := fmt.Sprintf("The value %s does not %s the %s %s.", request.ConfigValue.String(), mode.verb, mode.noun, quoted[0])
+	if len(quoted) > 1 {
+		detail = fmt.Sprintf("The value %s does not %s any of the %s %s.", request.ConfigValue.String(), mode.verb, mode.nouns, strings.Join(quoted, ", "))
+	}
+
+	if validator.CaseInsensitive {
+		detail = strings.TrimSuffix(detail, ".") + " (case-insensitive)."
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		mode.summary,
+		detail,
+	)
 }
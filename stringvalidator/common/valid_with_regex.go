@@ -20,19 +20,67 @@ package common
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var _ validator.String = RegexValidator{}
 
+// regexCache holds the compiled built-in regular expressions by pattern, shared by all the validators.
+// Only the patterns of MustCompileRegex and MustNewRegexValidator are cached, the patterns given at runtime are not.
+var regexCache sync.Map
+
+// MustCompileRegex compiles the pattern once and returns the compiled regular expression.
+// The following calls with the same pattern return the cached regular expression.
+// It panics if the pattern is invalid and is intended for the built-in patterns of the package.
+func MustCompileRegex(pattern string) *regexp.Regexp {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("common: MustCompileRegex(%q): %v", pattern, err))
+	}
+
+	actual, _ := regexCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp)
+}
+
+// RegexValidator validates that the value matches the regular expression Regex.
+// Use NewRegexValidator to compile the regular expression once, at construction.
 type RegexValidator struct {
 	Desc  string
 	Regex string
 
 	ErrorSummary string
 	ErrorDetail  string
+
+	// re is the compiled Regex. If nil (Ex: a RegexValidator literal), the regular
+	// expression is compiled at validation.
+	re *regexp.Regexp
+}
+
+// NewRegexValidator returns a copy of the settings with the regular expression compiled.
+// An error is returned if the regular expression is invalid.
+func NewRegexValidator(settings RegexValidator) (RegexValidator, error) {
+	re, err := regexp.Compile(settings.Regex)
+	if err != nil {
+		return RegexValidator{}, fmt.Errorf("invalid regular expression %q: %w", settings.Regex, err)
+	}
+
+	settings.re = re
+	return settings, nil
+}
+
+// MustNewRegexValidator is like NewRegexValidator but panics if the regular expression is invalid.
+// The regular expression is compiled with MustCompileRegex: it is intended for the built-in patterns of the package.
+func MustNewRegexValidator(settings RegexValidator) RegexValidator {
+	settings.re = MustCompileRegex(settings.Regex)
+	return settings
 }
 
 // Description describes the validation in plain text formatting.
@@ -55,7 +103,19 @@ func (validator RegexValidator) ValidateString(
 		return
 	}
 
-	re := regexp.MustCompile(validator.Regex)
+	// A RegexValidator literal is compiled at each validation, use NewRegexValidator to compile it once.
+	re := validator.re
+	if re == nil {
+		var err error
+		if re, err = regexp.Compile(validator.Regex); err != nil {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid validator configuration",
+				fmt.Sprintf("invalid regular expression %q: %s", validator.Regex, err),
+			)
+			return
+		}
+	}
 
 	if !re.MatchString(request.ConfigValue.ValueString()) {
 		response.Diagnostics.AddAttributeError(
@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+const macAddressRegex = `(?m)^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`
+
+func TestValidRegexValidator(t *testing.T) {
+	t.Parallel()
+
+	compiled, err := common.NewRegexValidator(common.RegexValidator{
+		Regex:        macAddressRegex,
+		ErrorSummary: "Failed to parse mac address",
+	})
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	type testCase struct {
+		val         types.String
+		validator   common.RegexValidator
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:       types.StringUnknown(),
+			validator: compiled,
+		},
+		"null": {
+			val:       types.StringNull(),
+			validator: compiled,
+		},
+		"compiled": {
+			val:       types.StringValue("00:50:56:01:02:03"),
+			validator: compiled,
+		},
+		"compiled-invalid": {
+			val:         types.StringValue("00:50:56"),
+			validator:   compiled,
+			expectError: true,
+		},
+		"literal": {
+			val:       types.StringValue("00:50:56:01:02:03"),
+			validator: common.RegexValidator{Regex: macAddressRegex},
+		},
+		"literal-invalid": {
+			val:         types.StringValue("00:50:56"),
+			validator:   common.RegexValidator{Regex: macAddressRegex},
+			expectError: true,
+		},
+		"literal-invalid-regex": {
+			val:         types.StringValue("00:50:56:01:02:03"),
+			validator:   common.RegexValidator{Regex: `(`},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestNewRegexValidatorInvalidRegex(t *testing.T) {
+	t.Parallel()
+
+	if _, err := common.NewRegexValidator(common.RegexValidator{Regex: `[a-`}); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
+
+func TestCompileRegexCache(t *testing.T) {
+	t.Parallel()
+
+	first := common.MustCompileRegex(macAddressRegex)
+	if second := common.MustCompileRegex(macAddressRegex); first != second {
+		t.Fatal("expected the same compiled regular expression for the same pattern")
+	}
+}
+
+func benchmarkRegexValidator(b *testing.B, v validator.String) {
+	b.Helper()
+
+	request := validator.StringRequest{
+		ConfigValue: types.StringValue("00:50:56:01:02:03"),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		response := validator.StringResponse{}
+		v.ValidateString(context.Background(), request, &response)
+	}
+}
+
+// BenchmarkRegexValidatorLiteral measures a RegexValidator literal, compiled at each validation.
+func BenchmarkRegexValidatorLiteral(b *testing.B) {
+	benchmarkRegexValidator(b, common.RegexValidator{Regex: macAddressRegex})
+}
+
+// BenchmarkRegexValidatorCompiled measures a RegexValidator compiled once by NewRegexValidator.
+func BenchmarkRegexValidatorCompiled(b *testing.B) {
+	v, err := common.NewRegexValidator(common.RegexValidator{Regex: macAddressRegex})
+	if err != nil {
+		b.Fatalf("got unexpected error: %s", err)
+	}
+	benchmarkRegexValidator(b, v)
+}
@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = cron{}
+
+// CronDialect is the syntax of a cron expression.
+type CronDialect string
+
+const (
+	// CronDialectStandard is the POSIX syntax with 5 fields: minute hour day-of-month month day-of-week.
+	// This is the default.
+	CronDialectStandard CronDialect = internal.CronDialectStandard
+	// CronDialectSeconds is the standard syntax with a leading second field (6 fields).
+	CronDialectSeconds CronDialect = internal.CronDialectSeconds
+	// CronDialectQuartz is the Quartz syntax with 6 or 7 fields: second minute hour day-of-month month day-of-week [year].
+	// One of the day-of-month and day-of-week fields must be ?. The L, W and # special characters are supported.
+	CronDialectQuartz CronDialect = internal.CronDialectQuartz
+)
+
+type CronParams struct {
+	// Dialect is the syntax of the cron expression.
+	Dialect CronDialect
+	// AllowMacros allows the macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly.
+	AllowMacros bool
+	// MinInterval is the minimum duration between two runs (Ex: 15 * time.Minute). If 0, there is no limit.
+	MinInterval time.Duration
+}
+
+func (p CronParams) description(format string) string {
+	fields := internal.CronFields(string(p.Dialect))
+
+	description := fmt.Sprintf("a cron expression with %d fields (%s)", len(fields), fmt.Sprintf(format, strings.Join(fields, " ")))
+	if p.Dialect == CronDialectQuartz {
+		description = fmt.Sprintf("a Quartz cron expression with 6 or 7 fields (%s)", fmt.Sprintf(format, strings.Join(fields, " ")))
+	}
+
+	if p.AllowMacros {
+		description += fmt.Sprintf(" or a macro (Ex: %s)", fmt.Sprintf(format, "@daily"))
+	}
+
+	if p.MinInterval > 0 {
+		description += fmt.Sprintf(", running at most every %s", internal.FormatDuration(p.MinInterval))
+	}
+
+	return description
+}
+
+type cron struct {
+	params CronParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator cron) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator cron) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator cron) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	schedule, err := internal.ParseCron(value, string(validator.params.Dialect), validator.params.AllowMacros)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("The cron expression %q is invalid: %s", value, err),
+		)
+		return
+	}
+
+	interval, ok, err := schedule.MinInterval()
+	switch {
+	case err != nil:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid cron expression",
+			fmt.Sprintf("The cron expression %q is invalid: %s", value, err),
+		)
+	case ok && validator.params.MinInterval > 0 && interval < validator.params.MinInterval:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Cron expression runs too often",
+			fmt.Sprintf("The cron expression %q runs every %s, expected at most every %s", value, internal.FormatDuration(interval), internal.FormatDuration(validator.params.MinInterval)),
+		)
+	}
+}
+
+// Cron validates that a string is a cron expression of the given dialect (Ex: 0 2 * * *).
+//
+// The field ranges (with the month and weekday names), the lists, the ranges and the steps are checked.
+// The expressions that never fire (Ex: 0 0 30 2 *) are rejected.
+// If MinInterval is set, the expressions running more often are rejected.
+//
+// Parameters:
+//   - settings: CronParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid cron expression.
+func Cron(settings CronParams) validator.String {
+	return &cron{
+		params: settings,
+	}
+}
@@ -0,0 +1,269 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidCronValidator(t *testing.T) {
+	t.Parallel()
+
+	seconds := stringvalidator.CronParams{Dialect: stringvalidator.CronDialectSeconds}
+	quartz := stringvalidator.CronParams{Dialect: stringvalidator.CronDialectQuartz}
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.CronParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-standard": {
+			val: types.StringValue("0 2 * * *"),
+		},
+		"valid-standard-lists-ranges-steps": {
+			val: types.StringValue("*/15 8-18/2 1,15 * 1-5"),
+		},
+		"valid-standard-names": {
+			val: types.StringValue("0 0 * jan-mar MON,WED,FRI"),
+		},
+		"valid-standard-sunday-7": {
+			val: types.StringValue("0 0 * * 7"),
+		},
+		"valid-standard-start-step": {
+			val: types.StringValue("5/10 * * * *"),
+		},
+		"valid-macro": {
+			val:   types.StringValue("@daily"),
+			param: stringvalidator.CronParams{AllowMacros: true},
+		},
+		"valid-seconds": {
+			val:   types.StringValue("30 0 2 * * *"),
+			param: seconds,
+		},
+		"valid-quartz": {
+			val:   types.StringValue("0 15 10 ? * MON-FRI"),
+			param: quartz,
+		},
+		"valid-quartz-year": {
+			val:   types.StringValue("0 15 10 * * ? 2026"),
+			param: quartz,
+		},
+		"valid-quartz-last-day": {
+			val:   types.StringValue("0 0 12 L * ?"),
+			param: quartz,
+		},
+		"valid-quartz-last-day-offset": {
+			val:   types.StringValue("0 0 12 L-3 * ?"),
+			param: quartz,
+		},
+		"valid-quartz-weekday": {
+			val:   types.StringValue("0 0 12 15W * ?"),
+			param: quartz,
+		},
+		"valid-quartz-last-weekday": {
+			val:   types.StringValue("0 0 12 LW * ?"),
+			param: quartz,
+		},
+		"valid-quartz-last-friday": {
+			val:   types.StringValue("0 0 12 ? * 6L"),
+			param: quartz,
+		},
+		"valid-quartz-nth": {
+			val:   types.StringValue("0 0 12 ? * 2#1"),
+			param: quartz,
+		},
+		"valid-min-interval": {
+			val:   types.StringValue("*/15 * * * *"),
+			param: stringvalidator.CronParams{MinInterval: 15 * time.Minute},
+		},
+		"valid-min-interval-uneven": {
+			// Runs at 0:00, 0:50, 1:00... the shortest interval is 10 minutes.
+			val:   types.StringValue("0,50 * * * *"),
+			param: stringvalidator.CronParams{MinInterval: 10 * time.Minute},
+		},
+		"valid-min-interval-daily": {
+			val:   types.StringValue("0 23 * * *"),
+			param: stringvalidator.CronParams{MinInterval: 24 * time.Hour},
+		},
+		"valid-min-interval-macro": {
+			val:   types.StringValue("@hourly"),
+			param: stringvalidator.CronParams{AllowMacros: true, MinInterval: time.Hour},
+		},
+		"valid-feb-29": {
+			val: types.StringValue("0 0 29 2 *"),
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-field-count": {
+			val:         types.StringValue("0 2 * *"),
+			expectError: true,
+		},
+		"invalid-seconds-with-standard": {
+			val:         types.StringValue("0 0 2 * * *"),
+			expectError: true,
+		},
+		"invalid-standard-with-seconds": {
+			val:         types.StringValue("0 2 * * *"),
+			param:       seconds,
+			expectError: true,
+		},
+		"invalid-minute-range": {
+			val:         types.StringValue("60 * * * *"),
+			expectError: true,
+		},
+		"invalid-hour-range": {
+			val:         types.StringValue("0 24 * * *"),
+			expectError: true,
+		},
+		"invalid-day-of-month-zero": {
+			val:         types.StringValue("0 0 0 * *"),
+			expectError: true,
+		},
+		"invalid-step-zero": {
+			val:         types.StringValue("*/0 * * * *"),
+			expectError: true,
+		},
+		"invalid-step-too-large": {
+			val:         types.StringValue("*/60 * * * *"),
+			expectError: true,
+		},
+		"invalid-step-missing": {
+			val:         types.StringValue("*/ * * * *"),
+			expectError: true,
+		},
+		"invalid-decreasing-range": {
+			val:         types.StringValue("0 18-8 * * *"),
+			expectError: true,
+		},
+		"invalid-empty-list-item": {
+			val:         types.StringValue("0,,30 * * * *"),
+			expectError: true,
+		},
+		"invalid-name": {
+			val:         types.StringValue("0 0 * * MONDAY"),
+			expectError: true,
+		},
+		"invalid-never-fires": {
+			val:         types.StringValue("0 0 30 2 *"),
+			expectError: true,
+		},
+		"invalid-macro-not-allowed": {
+			val:         types.StringValue("@daily"),
+			expectError: true,
+		},
+		"invalid-macro-unknown": {
+			val:         types.StringValue("@reboot"),
+			param:       stringvalidator.CronParams{AllowMacros: true},
+			expectError: true,
+		},
+		"invalid-question-mark-standard": {
+			val:         types.StringValue("0 0 ? * *"),
+			expectError: true,
+		},
+		"invalid-quartz-without-question-mark": {
+			val:         types.StringValue("0 0 12 * * MON"),
+			param:       quartz,
+			expectError: true,
+		},
+		"invalid-quartz-two-question-marks": {
+			val:         types.StringValue("0 0 12 ? * ?"),
+			param:       quartz,
+			expectError: true,
+		},
+		"invalid-quartz-day-of-week-zero": {
+			val:         types.StringValue("0 0 12 ? * 0"),
+			param:       quartz,
+			expectError: true,
+		},
+		"invalid-quartz-nth": {
+			val:         types.StringValue("0 0 12 ? * 2#6"),
+			param:       quartz,
+			expectError: true,
+		},
+		"invalid-quartz-year": {
+			val:         types.StringValue("0 0 12 ? * 2 1969"),
+			param:       quartz,
+			expectError: true,
+		},
+		"invalid-min-interval": {
+			val:         types.StringValue("*/5 * * * *"),
+			param:       stringvalidator.CronParams{MinInterval: 15 * time.Minute},
+			expectError: true,
+		},
+		"invalid-min-interval-uneven": {
+			val:         types.StringValue("0,50 * * * *"),
+			param:       stringvalidator.CronParams{MinInterval: 15 * time.Minute},
+			expectError: true,
+		},
+		"invalid-min-interval-across-days": {
+			// Runs at 23:30 and 00:00 the next day.
+			val:         types.StringValue("0,30 0,23 * * *"),
+			param:       stringvalidator.CronParams{MinInterval: time.Hour},
+			expectError: true,
+		},
+		"invalid-min-interval-seconds": {
+			val:         types.StringValue("*/30 * * * * *"),
+			param:       stringvalidator.CronParams{Dialect: stringvalidator.CronDialectSeconds, MinInterval: time.Minute},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Cron(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidCronValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Cron(stringvalidator.CronParams{
+		AllowMacros: true,
+		MinInterval: time.Hour,
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a cron expression with 5 fields (minute hour day-of-month month day-of-week) or a macro (Ex: @daily), running at most every 1 hour"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a cron expression with 5 fields (`minute hour day-of-month month day-of-week`) or a macro (Ex: `@daily`), running at most every 1 hour"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = duration{}
+
+// DurationFormat is the syntax of a duration.
+type DurationFormat string
+
+const (
+	// DurationFormatGo is the syntax of time.ParseDuration (Ex: 30s, 1h30m). This is the default.
+	DurationFormatGo DurationFormat = ""
+	// DurationFormatISO8601 is the ISO 8601 syntax (Ex: PT30S, PT1H30M, P1D).
+	// The years and months are rejected because they do not have a fixed duration.
+	DurationFormatISO8601 DurationFormat = "iso8601"
+)
+
+type DurationParams struct {
+	// Format is the syntax of the duration.
+	Format DurationFormat
+	// Min is the minimum duration (inclusive). If 0, there is no lower bound.
+	Min time.Duration
+	// Max is the maximum duration (inclusive). If 0, there is no upper bound.
+	Max time.Duration
+	// MultipleOf is the granularity of the duration (Ex: time.Minute for whole minutes). If 0, any duration is allowed.
+	MultipleOf time.Duration
+	// AllowZero allows the zero duration, even if it is lower than Min.
+	AllowZero bool
+}
+
+func (p DurationParams) description(format string) string {
+	description := "a duration in the "
+	switch p.Format {
+	case DurationFormatISO8601:
+		description += fmt.Sprintf("ISO 8601 format (Ex: %s)", fmt.Sprintf(format, "PT1H30M"))
+	default:
+		description += fmt.Sprintf("Go format (Ex: %s)", fmt.Sprintf(format, "1h30m"))
+	}
+
+	var constraints []string
+	switch {
+	case p.Min > 0 && p.Max > 0:
+		constraints = append(constraints, fmt.Sprintf("between %s and %s", internal.FormatDuration(p.Min), internal.FormatDuration(p.Max)))
+	case p.Min > 0:
+		constraints = append(constraints, fmt.Sprintf("of at least %s", internal.FormatDuration(p.Min)))
+	case p.Max > 0:
+		constraints = append(constraints, fmt.Sprintf("of at most %s", internal.FormatDuration(p.Max)))
+	}
+
+	if p.MultipleOf > 0 {
+		constraints = append(constraints, fmt.Sprintf("a multiple of %s", internal.FormatDuration(p.MultipleOf)))
+	}
+
+	if p.AllowZero {
+		constraints = append(constraints, fmt.Sprintf("%s is allowed", fmt.Sprintf(format, p.zero())))
+	} else {
+		constraints = append(constraints, "not zero")
+	}
+
+	return description + ", " + strings.Join(constraints, ", ")
+}
+
+// zero returns the zero duration in the format of the settings.
+func (p DurationParams) zero() string {
+	if p.Format == DurationFormatISO8601 {
+		return "PT0S"
+	}
+
+	return "0s"
+}
+
+func (p DurationParams) parse(value string) (time.Duration, error) {
+	switch p.Format {
+	case DurationFormatGo:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid duration (Ex: 1h30m)", value)
+		}
+		return d, nil
+	case DurationFormatISO8601:
+		return internal.ParseISO8601Duration(value)
+	default:
+		return 0, fmt.Errorf("unknown duration format %q", p.Format)
+	}
+}
+
+type duration struct {
+	params DurationParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator duration) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator duration) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator duration) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	d, err := validator.params.parse(value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid duration",
+			err.Error(),
+		)
+		return
+	}
+
+	switch {
+	case d < 0:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid duration",
+			fmt.Sprintf("The duration %q must not be negative", value),
+		)
+		return
+	case d == 0:
+		if !validator.params.AllowZero {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid duration",
+				fmt.Sprintf("The duration %q must not be zero", value),
+			)
+		}
+		return
+	}
+
+	if validator.params.Min > 0 && d < validator.params.Min {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Duration out of range",
+			fmt.Sprintf("The duration %q (%s) must be at least %s", value, internal.FormatDuration(d), internal.FormatDuration(validator.params.Min)),
+		)
+	}
+
+	if validator.params.Max > 0 && d > validator.params.Max {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Duration out of range",
+			fmt.Sprintf("The duration %q (%s) must be at most %s", value, internal.FormatDuration(d), internal.FormatDuration(validator.params.Max)),
+		)
+	}
+
+	if validator.params.MultipleOf > 0 && d%validator.params.MultipleOf != 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid duration",
+			fmt.Sprintf("The duration %q (%s) must be a multiple of %s", value, internal.FormatDuration(d), internal.FormatDuration(validator.params.MultipleOf)),
+		)
+	}
+}
+
+// Duration validates that a string is a duration in the Go format (Ex: 30s, 1h30m)
+// or in the ISO 8601 format (Ex: PT30S, PT1H30M) depending on Format.
+//
+// The negative durations are rejected. The zero duration is rejected unless AllowZero is set,
+// in which case it is not checked against Min and MultipleOf.
+//
+// Parameters:
+//   - settings: DurationParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid duration.
+func Duration(settings DurationParams) validator.String {
+	return &duration{
+		params: settings,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	iso := stringvalidator.DurationFormatISO8601
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.DurationParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-go": {
+			val: types.StringValue("1h30m"),
+		},
+		"valid-go-fraction": {
+			val: types.StringValue("1.5s"),
+		},
+		"valid-iso8601": {
+			val:   types.StringValue("PT1H30M"),
+			param: stringvalidator.DurationParams{Format: iso},
+		},
+		"valid-iso8601-days": {
+			val:   types.StringValue("P1DT12H"),
+			param: stringvalidator.DurationParams{Format: iso},
+		},
+		"valid-iso8601-weeks": {
+			val:   types.StringValue("P2W"),
+			param: stringvalidator.DurationParams{Format: iso},
+		},
+		"valid-iso8601-fraction": {
+			val:   types.StringValue("PT0,5S"),
+			param: stringvalidator.DurationParams{Format: iso},
+		},
+		"valid-bounds": {
+			val: types.StringValue("5m"),
+			param: stringvalidator.DurationParams{
+				Min: time.Minute,
+				Max: time.Hour,
+			},
+		},
+		"valid-bounds-inclusive": {
+			val: types.StringValue("PT1H"),
+			param: stringvalidator.DurationParams{
+				Format: iso,
+				Min:    time.Minute,
+				Max:    time.Hour,
+			},
+		},
+		"valid-multiple-of": {
+			val: types.StringValue("90m"),
+			param: stringvalidator.DurationParams{
+				MultipleOf: time.Minute,
+			},
+		},
+		"valid-zero-allowed": {
+			val: types.StringValue("0s"),
+			param: stringvalidator.DurationParams{
+				Min:       time.Minute,
+				AllowZero: true,
+			},
+		},
+		"valid-zero-allowed-iso8601": {
+			val: types.StringValue("PT0S"),
+			param: stringvalidator.DurationParams{
+				Format:    iso,
+				AllowZero: true,
+			},
+		},
+		"invalid-zero": {
+			val:         types.StringValue("0s"),
+			expectError: true,
+		},
+		"invalid-negative": {
+			val:         types.StringValue("-5s"),
+			expectError: true,
+		},
+		"invalid-go-syntax": {
+			val:         types.StringValue("5 minutes"),
+			expectError: true,
+		},
+		"invalid-go-missing-unit": {
+			val:         types.StringValue("30"),
+			expectError: true,
+		},
+		"invalid-iso8601-with-go-format": {
+			val:         types.StringValue("PT5M"),
+			expectError: true,
+		},
+		"invalid-go-with-iso8601-format": {
+			val:         types.StringValue("5m"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-empty": {
+			val:         types.StringValue("P"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-empty-time": {
+			val:         types.StringValue("P1DT"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-months": {
+			val:         types.StringValue("P1M"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-years": {
+			val:         types.StringValue("P1Y"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-order": {
+			val:         types.StringValue("PT5M1H"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-iso8601-overflow": {
+			val:         types.StringValue("P99999999999D"),
+			param:       stringvalidator.DurationParams{Format: iso},
+			expectError: true,
+		},
+		"invalid-below-min": {
+			val: types.StringValue("30s"),
+			param: stringvalidator.DurationParams{
+				Min: time.Minute,
+			},
+			expectError: true,
+		},
+		"invalid-above-max": {
+			val: types.StringValue("PT1H1S"),
+			param: stringvalidator.DurationParams{
+				Format: iso,
+				Max:    time.Hour,
+			},
+			expectError: true,
+		},
+		"invalid-multiple-of": {
+			val: types.StringValue("90s"),
+			param: stringvalidator.DurationParams{
+				MultipleOf: time.Minute,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Duration(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidDurationValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Duration(stringvalidator.DurationParams{
+		Format:     stringvalidator.DurationFormatISO8601,
+		Min:        time.Minute,
+		Max:        36 * time.Hour,
+		MultipleOf: time.Minute,
+		AllowZero:  true,
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a duration in the ISO 8601 format (Ex: PT1H30M), between 1 minute and 1 day 12 hours, a multiple of 1 minute, PT0S is allowed"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a duration in the ISO 8601 format (Ex: `PT1H30M`), between 1 minute and 1 day 12 hours, a multiple of 1 minute, `PT0S` is allowed"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -34,12 +34,70 @@ const (
 	FormatsIsBase64 FormatsValidatorType = "is_base64"
 	FormatsIsUUIDv4 FormatsValidatorType = "is_uuid_v4"
 	FormatsIsURN    FormatsValidatorType = "is_urn"
+	FormatsIsBase32 FormatsValidatorType = "is_base32"
+	FormatsIsHex    FormatsValidatorType = "is_hex"
+
+	FormatsIsUUID   FormatsValidatorType = "is_uuid"
+	FormatsIsUUIDv1 FormatsValidatorType = "is_uuid_v1"
+	FormatsIsUUIDv2 FormatsValidatorType = "is_uuid_v2"
+	FormatsIsUUIDv3 FormatsValidatorType = "is_uuid_v3"
+	FormatsIsUUIDv5 FormatsValidatorType = "is_uuid_v5"
+	FormatsIsUUIDv6 FormatsValidatorType = "is_uuid_v6"
+	FormatsIsUUIDv7 FormatsValidatorType = "is_uuid_v7"
+	FormatsIsUUIDv8 FormatsValidatorType = "is_uuid_v8"
+
+	FormatsIsJSON       FormatsValidatorType = "is_json"
+	FormatsIsJSONObject FormatsValidatorType = "is_json_object"
+	FormatsIsJSONArray  FormatsValidatorType = "is_json_array"
+
+	FormatsIsYAML      FormatsValidatorType = "is_yaml"
+	FormatsIsCloudInit FormatsValidatorType = "is_cloud_init"
 )
 
 var formatsTypesFunc = map[FormatsValidatorType]func() validator.String{
 	FormatsIsBase64: formatstypes.IsBase64,
 	FormatsIsUUIDv4: formatstypes.IsUUIDv4,
 	FormatsIsURN:    formatstypes.IsURN,
+	FormatsIsBase32: formatstypes.IsBase32,
+	FormatsIsHex:    formatstypes.IsHex,
+
+	FormatsIsUUID:   formatsIsUUIDVersions(),
+	FormatsIsUUIDv1: formatsIsUUIDVersions(1),
+	FormatsIsUUIDv2: formatsIsUUIDVersions(2),
+	FormatsIsUUIDv3: formatsIsUUIDVersions(3),
+	FormatsIsUUIDv5: formatsIsUUIDVersions(5),
+	FormatsIsUUIDv6: formatsIsUUIDVersions(6),
+	FormatsIsUUIDv7: formatsIsUUIDVersions(7),
+	FormatsIsUUIDv8: formatsIsUUIDVersions(8),
+
+	FormatsIsJSON:       formatsIsJSONKind(""),
+	FormatsIsJSONObject: formatsIsJSONKind(formatstypes.JSONKindObject),
+	FormatsIsJSONArray:  formatsIsJSONKind(formatstypes.JSONKindArray),
+
+	FormatsIsYAML: formatstypes.IsYAML,
+	FormatsIsCloudInit: func() validator.String {
+		return formatstypes.IsCloudInit(formatstypes.CloudInitParams{})
+	},
+}
+
+// formatsIsUUIDVersions returns a constructor of a UUID validator restricted to the given versions.
+// If no version is given, all the versions defined by RFC 9562 are allowed.
+func formatsIsUUIDVersions(versions ...int) func() validator.String {
+	return func() validator.String {
+		return formatstypes.IsUUID(formatstypes.UUIDParams{
+			Versions: versions,
+		})
+	}
+}
+
+// formatsIsJSONKind returns a constructor of a JSON validator requiring the given top-level kind.
+// If kind is empty, any JSON value is accepted.
+func formatsIsJSONKind(kind formatstypes.JSONKind) func() validator.String {
+	return func() validator.String {
+		return formatstypes.IsJSON(formatstypes.JSONParams{
+			Kind: kind,
+		})
+	}
 }
 
 type FormatsValidatorType string
@@ -57,6 +57,60 @@ func TestFormatsValidator(t *testing.T) {
 				stringvalidator.FormatsIsBase64,
 			},
 		},
+		"valid-base32": {
+			val: types.StringValue("ORSXG5A="),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsBase32,
+			},
+		},
+		"invalid-base32": {
+			val: types.StringValue("dGVzdA=="),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsBase32,
+			},
+			expectError: true,
+		},
+		"valid-hex": {
+			val: types.StringValue("74657374"),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsHex,
+			},
+		},
+		"invalid-hex": {
+			val: types.StringValue("test"),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsHex,
+			},
+			expectError: true,
+		},
+		"valid-json-object": {
+			val: types.StringValue(`{"name": "test"}`),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsJSONObject,
+			},
+		},
+		"invalid-json-object": {
+			val: types.StringValue(`["test"]`),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsJSONObject,
+			},
+			expectError: true,
+		},
+		"valid-json-array-or-object": {
+			val: types.StringValue(`["test"]`),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsJSONObject,
+				stringvalidator.FormatsIsJSONArray,
+			},
+			ComparatorOR: true,
+		},
+		"invalid-json": {
+			val: types.StringValue(`{"name": }`),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsJSON,
+			},
+			expectError: true,
+		},
 		"invalid-uuid": {
 			val: types.StringValue("urn:test:demo:4aeb40d8-038c-4e77-8181-a7054f583b12"),
 			typesOfFormats: []stringvalidator.FormatsValidatorType{
@@ -70,6 +124,25 @@ func TestFormatsValidator(t *testing.T) {
 				stringvalidator.FormatsIsUUIDv4,
 			},
 		},
+		"valid-uuid-any-version": {
+			val: types.StringValue("018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsUUID,
+			},
+		},
+		"valid-uuid-v7": {
+			val: types.StringValue("018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsUUIDv7,
+			},
+		},
+		"invalid-uuid-v1": {
+			val: types.StringValue("018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+			typesOfFormats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsUUIDv1,
+			},
+			expectError: true,
+		},
 		"valid-urn": {
 			val: types.StringValue("urn:test:demo:4aeb40d8-038c-4e77-8181-a7054f583b12"),
 			typesOfFormats: []stringvalidator.FormatsValidatorType{
@@ -159,12 +232,42 @@ func TestFormatsValidatorDescription(t *testing.T) {
 				stringvalidator.FormatsIsBase64,
 			},
 		},
+		"base32": {
+			description: "The value must respect the following rule : must be a valid base32 string",
+			Formats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsBase32,
+			},
+		},
+		"hex": {
+			description: "The value must respect the following rule : must be a valid hexadecimal string",
+			Formats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsHex,
+			},
+		},
+		"json-object": {
+			description: "The value must respect the following rule : must be a valid JSON object",
+			Formats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsJSONObject,
+			},
+		},
 		"uuid": {
 			description: "The value must respect the following rule : must be a valid UUID v4",
 			Formats: []stringvalidator.FormatsValidatorType{
 				stringvalidator.FormatsIsUUIDv4,
 			},
 		},
+		"uuid-any-version": {
+			description: "The value must respect the following rule : must be a valid UUID",
+			Formats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsUUID,
+			},
+		},
+		"uuid-v7": {
+			description: "The value must respect the following rule : must be a valid UUID (v7)",
+			Formats: []stringvalidator.FormatsValidatorType{
+				stringvalidator.FormatsIsUUIDv7,
+			},
+		},
 		"urn": {
 			description: "The value must respect the following rule : must be a valid URN",
 			Formats: []stringvalidator.FormatsValidatorType{
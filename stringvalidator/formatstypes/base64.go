@@ -20,29 +20,148 @@ package formatstypes
 
 import (
 	"context"
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ validator.String = base64Validator{}
+var _ validator.String = encodedValidator{}
 
-type base64Validator struct{}
+// Encoding is a binary-to-text encoding.
+type Encoding string
+
+const (
+	// EncodingBase64 is the standard base64 encoding with padding (RFC 4648 section 4).
+	EncodingBase64 Encoding = "base64"
+	// EncodingBase64URL is the URL and filename safe base64 encoding with padding (RFC 4648 section 5).
+	EncodingBase64URL Encoding = "base64url"
+	// EncodingBase64Raw is the standard base64 encoding without padding.
+	EncodingBase64Raw Encoding = "base64raw"
+	// EncodingBase64RawURL is the URL and filename safe base64 encoding without padding.
+	EncodingBase64RawURL Encoding = "base64rawurl"
+	// EncodingBase32 is the standard base32 encoding with padding (RFC 4648 section 6).
+	EncodingBase32 Encoding = "base32"
+	// EncodingHex is the hexadecimal encoding (RFC 4648 section 8), lowercase or uppercase.
+	EncodingHex Encoding = "hex"
+)
+
+var encodingNames = map[Encoding]string{
+	EncodingBase64:       "base64",
+	EncodingBase64URL:    "base64 URL-safe",
+	EncodingBase64Raw:    "unpadded base64",
+	EncodingBase64RawURL: "unpadded base64 URL-safe",
+	EncodingBase32:       "base32",
+	EncodingHex:          "hexadecimal",
+}
+
+// String returns the human readable name of the encoding.
+func (e Encoding) String() string {
+	if name, ok := encodingNames[e]; ok {
+		return name
+	}
+
+	return string(e)
+}
+
+// Decode decodes the value with the encoding.
+func (e Encoding) Decode(value string) ([]byte, error) {
+	switch e {
+	case EncodingBase64:
+		return base64.StdEncoding.DecodeString(value)
+	case EncodingBase64URL:
+		return base64.URLEncoding.DecodeString(value)
+	case EncodingBase64Raw:
+		return base64.RawStdEncoding.DecodeString(value)
+	case EncodingBase64RawURL:
+		return base64.RawURLEncoding.DecodeString(value)
+	case EncodingBase32:
+		return base32.StdEncoding.DecodeString(value)
+	case EncodingHex:
+		return hex.DecodeString(value)
+	default:
+		return nil, fmt.Errorf("unknown encoding %q", string(e))
+	}
+}
+
+// EncodedParams is the configuration of the IsEncoded validator.
+type EncodedParams struct {
+	// Encodings is the list of accepted encodings.
+	// The value is valid if it can be decoded with at least one of them.
+	// If empty, EncodingBase64 is used.
+	Encodings []Encoding
+	// MinDecodedLength is the minimum length in bytes of the decoded value. Ignored if 0.
+	MinDecodedLength int
+	// MaxDecodedLength is the maximum length in bytes of the decoded value. Ignored if 0.
+	MaxDecodedLength int
+	// DecodedValidator is applied to the decoded value (Ex: IsPEM(), IsGzip()).
+	DecodedValidator validator.String
+}
+
+type encodedValidator struct {
+	params EncodedParams
+}
 
 // Description describes the validation in plain text formatting.
-func (validator base64Validator) Description(_ context.Context) string {
-	return "must be a valid base64 string"
+func (validator encodedValidator) Description(ctx context.Context) string {
+	description := fmt.Sprintf("must be a valid %s string", validator.encodingsDescription())
+
+	switch {
+	case validator.params.MinDecodedLength > 0 && validator.params.MaxDecodedLength > 0:
+		description += fmt.Sprintf(" with a decoded length between %d and %d bytes", validator.params.MinDecodedLength, validator.params.MaxDecodedLength)
+	case validator.params.MinDecodedLength > 0:
+		description += fmt.Sprintf(" with a decoded length of at least %d bytes", validator.params.MinDecodedLength)
+	case validator.params.MaxDecodedLength > 0:
+		description += fmt.Sprintf(" with a decoded length of at most %d bytes", validator.params.MaxDecodedLength)
+	}
+
+	if validator.params.DecodedValidator != nil {
+		description += fmt.Sprintf(" and the decoded value %s", validator.params.DecodedValidator.Description(ctx))
+	}
+
+	return description
 }
 
 // MarkdownDescription describes the validation in Markdown formatting.
-func (validator base64Validator) MarkdownDescription(ctx context.Context) string {
+func (validator encodedValidator) MarkdownDescription(ctx context.Context) string {
 	return validator.Description(ctx)
 }
 
+func (validator encodedValidator) encodings() []Encoding {
+	if len(validator.params.Encodings) == 0 {
+		return []Encoding{EncodingBase64}
+	}
+
+	return validator.params.Encodings
+}
+
+func (validator encodedValidator) encodingsDescription() string {
+	names := make([]string, 0, len(validator.encodings()))
+	for _, e := range validator.encodings() {
+		names = append(names, e.String())
+	}
+
+	return strings.Join(names, " or ")
+}
+
+// validateDecodedValue runs the validator on the decoded value.
+// The other fields of the request (Ex: the configuration) are kept for the validators reading other attributes.
+func validateDecodedValue(ctx context.Context, v validator.String, request validator.StringRequest, decoded []byte) diag.Diagnostics {
+	request.ConfigValue = types.StringValue(string(decoded))
+	response := new(validator.StringResponse)
+	v.ValidateString(ctx, request, response)
+
+	return response.Diagnostics
+}
+
 // Validate performs the validation.
-func (validator base64Validator) ValidateString(
-	_ context.Context,
+func (validator encodedValidator) ValidateString(
+	ctx context.Context,
 	request validator.StringRequest,
 	response *validator.StringResponse,
 ) {
@@ -50,11 +169,55 @@ func (validator base64Validator) ValidateString(
 		return
 	}
 
-	if _, err := base64.StdEncoding.DecodeString(request.ConfigValue.ValueString()); err != nil {
+	var (
+		decoded []byte
+		err     error
+	)
+
+	for _, e := range validator.encodings() {
+		if decoded, err = e.Decode(request.ConfigValue.ValueString()); err == nil {
+			break
+		}
+	}
+
+	if err != nil {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
-			"Failed to parse base64 string",
+			fmt.Sprintf("Failed to parse %s string", validator.encodingsDescription()),
 			fmt.Sprintf("invalid value: %s", request.ConfigValue.String()),
 		)
+		return
+	}
+
+	if validator.params.MinDecodedLength > 0 && len(decoded) < validator.params.MinDecodedLength {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid decoded length",
+			fmt.Sprintf("The decoded value is %d bytes long, expected at least %d bytes", len(decoded), validator.params.MinDecodedLength),
+		)
+		return
+	}
+
+	if validator.params.MaxDecodedLength > 0 && len(decoded) > validator.params.MaxDecodedLength {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid decoded length",
+			fmt.Sprintf("The decoded value is %d bytes long, expected at most %d bytes", len(decoded), validator.params.MaxDecodedLength),
+		)
+		return
+	}
+
+	if validator.params.DecodedValidator == nil {
+		return
+	}
+
+	decodedDiags := validateDecodedValue(ctx, validator.params.DecodedValidator, request, decoded)
+
+	for _, d := range decodedDiags.Errors() {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid decoded value",
+			fmt.Sprintf("%s: %s", d.Summary(), d.Detail()),
+		)
 	}
 }
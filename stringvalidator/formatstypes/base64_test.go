@@ -81,3 +81,200 @@ func TestBase64Validator(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodedValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		params      formatstypes.EncodedParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-default": {
+			val: types.StringValue("dGVzdA=="),
+		},
+		"valid-url": {
+			// "??>" is encoded as "Pz8-" in URL-safe base64 and "Pz8+" in standard base64
+			val: types.StringValue("Pz8-"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64URL},
+			},
+		},
+		"valid-raw": {
+			val: types.StringValue("dGVzdA"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64Raw},
+			},
+		},
+		"valid-raw-url": {
+			val: types.StringValue("Pz8-"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64RawURL},
+			},
+		},
+		"valid-one-of-encodings": {
+			val: types.StringValue("dGVzdA"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64, formatstypes.EncodingBase64Raw},
+			},
+		},
+		"valid-base32": {
+			val: types.StringValue("ORSXG5A="),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase32},
+			},
+		},
+		"valid-hex": {
+			val: types.StringValue("74657374"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingHex},
+			},
+		},
+		"valid-length": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				MinDecodedLength: 4,
+				MaxDecodedLength: 4,
+			},
+		},
+		"valid-decoded-pem": {
+			val: types.StringValue("LS0tLS1CRUdJTiBURVNULS0tLS0KZEdWemRBPT0KLS0tLS1FTkQgVEVTVC0tLS0tCg=="),
+			params: formatstypes.EncodedParams{
+				DecodedValidator: formatstypes.IsPEM(),
+			},
+		},
+		"valid-decoded-gzip": {
+			val: types.StringValue("H4sIAAAAAAAAAytJLS4BAAx+f9gEAAAA"),
+			params: formatstypes.EncodedParams{
+				DecodedValidator: formatstypes.IsGzip(),
+			},
+		},
+		"invalid-url-with-std": {
+			val: types.StringValue("Pz8+"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64URL},
+			},
+			expectError: true,
+		},
+		"invalid-unpadded-with-std": {
+			val:         types.StringValue("dGVzdA"),
+			expectError: true,
+		},
+		"invalid-padded-with-raw": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64Raw},
+			},
+			expectError: true,
+		},
+		"invalid-hex": {
+			val: types.StringValue("7465737"),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingHex},
+			},
+			expectError: true,
+		},
+		"invalid-too-short": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				MinDecodedLength: 5,
+			},
+			expectError: true,
+		},
+		"invalid-too-long": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				MaxDecodedLength: 3,
+			},
+			expectError: true,
+		},
+		"invalid-decoded-pem": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				DecodedValidator: formatstypes.IsPEM(),
+			},
+			expectError: true,
+		},
+		"invalid-decoded-gzip": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				DecodedValidator: formatstypes.IsGzip(),
+			},
+			expectError: true,
+		},
+		"invalid-unknown-encoding": {
+			val: types.StringValue("dGVzdA=="),
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{"base58"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsEncoded(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestEncodedValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		params      formatstypes.EncodedParams
+		description string
+	}
+	tests := map[string]testCase{
+		"default": {
+			description: "must be a valid base64 string",
+		},
+		"multiple-encodings": {
+			params: formatstypes.EncodedParams{
+				Encodings: []formatstypes.Encoding{formatstypes.EncodingBase64URL, formatstypes.EncodingBase64RawURL},
+			},
+			description: "must be a valid base64 URL-safe or unpadded base64 URL-safe string",
+		},
+		"length-and-content": {
+			params: formatstypes.EncodedParams{
+				Encodings:        []formatstypes.Encoding{formatstypes.EncodingHex},
+				MinDecodedLength: 1,
+				MaxDecodedLength: 1024,
+				DecodedValidator: formatstypes.IsGzip(),
+			},
+			description: "must be a valid hexadecimal string with a decoded length between 1 and 1024 bytes and the decoded value must be a valid gzip stream",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			validator := formatstypes.IsEncoded(test.params)
+			if validator.Description(context.Background()) != test.description {
+				t.Fatalf("got unexpected description: %s != %s", validator.Description(context.Background()), test.description)
+			}
+			if validator.MarkdownDescription(context.Background()) != test.description {
+				t.Fatalf("got unexpected markdown description: %s != %s", validator.MarkdownDescription(context.Background()), test.description)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"gopkg.in/yaml.v3"
+)
+
+var _ validator.String = cloudInitValidator{}
+
+const (
+	// CloudInitDefaultMaxSize is the default maximum size of the user-data (16 KiB).
+	CloudInitDefaultMaxSize = 16 << 10
+
+	cloudInitConfigHeader = "#cloud-config"
+)
+
+// cloudInitModuleKeys is the list of the top-level keys handled by the cloud-config modules.
+// See https://cloudinit.readthedocs.io/en/latest/reference/modules.html
+var cloudInitModuleKeys = []string{
+	"allow_public_ssh_keys", "ansible", "apk_repos", "apt", "apt_pipelining", "apt_reboot_if_required",
+	"apt_update", "apt_upgrade", "authkey_hash", "autoinstall", "bootcmd", "byobu_by_default",
+	"ca-certs", "ca_certs", "chef", "chpasswd", "cloud_config_modules", "cloud_final_modules",
+	"cloud_init_modules", "create_hostname_file", "datasource", "datasource_list", "device_aliases",
+	"disable_ec2_metadata", "disable_root", "disable_root_opts", "disk_setup", "drivers", "fan",
+	"final_message", "fqdn", "fs_setup", "groups", "growpart", "hostname", "keyboard", "landscape",
+	"locale", "locale_configfile", "lxd", "manage_etc_hosts", "manage_resolv_conf", "mcollective",
+	"merge_how", "merge_type", "mount_default_fields", "mounts", "no_ssh_fingerprints", "ntp",
+	"output", "package_reboot_if_required", "package_update", "package_upgrade", "packages",
+	"password", "phone_home", "power_state", "prefer_fqdn_over_hostname", "preserve_hostname",
+	"puppet", "random_seed", "redhat_subscription", "reporting", "resize_rootfs", "resolv_conf",
+	"rh_subscription", "rsyslog", "runcmd", "salt_minion", "seed_random", "snap", "spacewalk",
+	"ssh", "ssh_authorized_keys", "ssh_deletekeys", "ssh_fp_console_blacklist", "ssh_genkeytypes",
+	"ssh_import_id", "ssh_key_console_blacklist", "ssh_keys", "ssh_publish_hostkeys", "ssh_pwauth",
+	"ssh_quiet_keygen", "swap", "system_info", "timezone", "ubuntu_advantage", "ubuntu_pro",
+	"updates", "user", "users", "vendor_data", "wireguard", "write_files", "yum_repo_dir",
+	"yum_repos", "zypper",
+}
+
+// CloudInitParams is the configuration of the IsCloudInit validator.
+type CloudInitParams struct {
+	// MaxSize is the maximum size in bytes of the value, as sent to the platform
+	// (after the optional gzip and base64 encoding).
+	// If 0, CloudInitDefaultMaxSize is used.
+	MaxSize int
+	// AllowedKeys is a list of additional top-level keys accepted in the cloud-config documents
+	// (Ex: keys handled by a custom module).
+	AllowedKeys []string
+	// AllowUnknownKeys disables the check of the top-level keys of the cloud-config documents.
+	AllowUnknownKeys bool
+}
+
+type cloudInitValidator struct {
+	params CloudInitParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator cloudInitValidator) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator cloudInitValidator) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+func (validator cloudInitValidator) description(format string) string {
+	return fmt.Sprintf("must be a valid cloud-init user-data ("+format+" document, shell script or MIME multipart archive, optionally gzip compressed and base64 encoded) of at most %d bytes", cloudInitConfigHeader, validator.maxSize())
+}
+
+func (validator cloudInitValidator) maxSize() int {
+	if validator.params.MaxSize <= 0 {
+		return CloudInitDefaultMaxSize
+	}
+
+	return validator.params.MaxSize
+}
+
+// Validate performs the validation.
+func (validator cloudInitValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if len(value) > validator.maxSize() {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid cloud-init user-data size",
+			fmt.Sprintf("The user-data is %d bytes long, expected at most %d bytes", len(value), validator.maxSize()),
+		)
+		return
+	}
+
+	data, err := validator.decode(value)
+	if err == nil {
+		err = validator.validate(data)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid cloud-init user-data",
+			err.Error(),
+		)
+	}
+}
+
+// decode returns the user-data without the optional base64 and gzip encodings.
+func (validator cloudInitValidator) decode(value string) ([]byte, error) {
+	data := []byte(value)
+
+	// A plain user-data starts with a header (#) or a MIME header and is never valid base64.
+	if decoded, err := EncodingBase64.Decode(strings.TrimSpace(value)); err == nil {
+		data = decoded
+	}
+
+	if isGzip(data) {
+		decompressed, err := gunzip(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress the gzip stream: %w", err)
+		}
+		data = decompressed
+	}
+
+	return data, nil
+}
+
+func (validator cloudInitValidator) validate(data []byte) error {
+	switch {
+	case bytes.HasPrefix(data, []byte(cloudInitConfigHeader+"\n")), bytes.HasPrefix(data, []byte(cloudInitConfigHeader+"\r\n")), string(data) == cloudInitConfigHeader:
+		return validator.validateCloudConfig(data)
+	case bytes.HasPrefix(data, []byte("#!")):
+		return nil
+	case bytes.HasPrefix(data, []byte("Content-Type:")), bytes.HasPrefix(data, []byte("MIME-Version:")):
+		return validator.validateMultipart(data)
+	default:
+		return fmt.Errorf("the user-data must start with %q, a shebang (#!) or a MIME multipart header (Content-Type: multipart/mixed)", cloudInitConfigHeader)
+	}
+}
+
+func (validator cloudInitValidator) validateCloudConfig(data []byte) error {
+	documents, err := parseYAML(string(data))
+	if err != nil {
+		return fmt.Errorf("the cloud-config is not a valid YAML document: %w", err)
+	}
+
+	if len(documents) == 0 {
+		return nil
+	}
+
+	if len(documents) > 1 {
+		return errors.New("the cloud-config must contain a single YAML document")
+	}
+
+	root := documents[0]
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("the cloud-config must be a YAML mapping, line %d", root.Line)
+	}
+
+	if validator.params.AllowUnknownKeys {
+		return nil
+	}
+
+	var unknown []string
+	for i := 0; i < len(root.Content); i += 2 {
+		key := root.Content[i]
+		if !validator.isKnownKey(key.Value) {
+			unknown = append(unknown, fmt.Sprintf("%q (line %d)", key.Value, key.Line))
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("the cloud-config contains unknown top-level keys: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+func (validator cloudInitValidator) isKnownKey(key string) bool {
+	for _, k := range cloudInitModuleKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	for _, k := range validator.params.AllowedKeys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (validator cloudInitValidator) validateMultipart(data []byte) error {
+	message, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to parse the MIME headers: %w", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("failed to parse the MIME content type: %w", err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return fmt.Errorf("the MIME content type %q is not multipart", mediaType)
+	}
+
+	reader := multipart.NewReader(message.Body, params["boundary"])
+	for i := 1; ; i++ {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read the MIME part %d: %w", i, err)
+		}
+
+		partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("failed to parse the content type of the MIME part %d: %w", i, err)
+		}
+
+		if partType != "text/cloud-config" {
+			continue
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return fmt.Errorf("failed to read the MIME part %d: %w", i, err)
+		}
+
+		if err := validator.validateCloudConfig(content); err != nil {
+			return fmt.Errorf("MIME part %d: %w", i, err)
+		}
+	}
+}
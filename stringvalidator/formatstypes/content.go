@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = pemValidator{}
+	_ validator.String = gzipValidator{}
+)
+
+// gzipMaxDecompressedSize bounds the decompression done at each plan, to protect against gzip bombs.
+// A stream holding more data is rejected.
+const gzipMaxDecompressedSize = 1 << 20
+
+var errGzipTooLarge = errors.New("decompressed content exceeds 1 MiB")
+
+type pemValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator pemValidator) Description(_ context.Context) string {
+	return "must be a valid PEM encoded string"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator pemValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator pemValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	block, rest := pem.Decode([]byte(request.ConfigValue.ValueString()))
+	if block == nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse PEM string",
+			"This value does not contain any PEM block",
+		)
+		return
+	}
+
+	for len(bytes.TrimSpace(rest)) > 0 {
+		if block, rest = pem.Decode(rest); block == nil {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Failed to parse PEM string",
+				"This value contains data that is not a PEM block",
+			)
+			return
+		}
+	}
+}
+
+type gzipValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator gzipValidator) Description(_ context.Context) string {
+	return "must be a valid gzip stream"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator gzipValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator gzipValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkGzip([]byte(request.ConfigValue.ValueString())); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse gzip stream",
+			fmt.Sprintf("This value is not a valid gzip stream: %s", err),
+		)
+	}
+}
+
+// checkGzip decompresses the gzip stream without keeping the decompressed data.
+// An error is returned if the decompressed data exceeds gzipMaxDecompressedSize bytes.
+func checkGzip(data []byte) error {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// One more byte is read to detect the streams exceeding the limit.
+	n, err := io.Copy(io.Discard, io.LimitReader(r, gzipMaxDecompressedSize+1))
+	if err != nil {
+		return err
+	}
+	if n > gzipMaxDecompressedSize {
+		return errGzipTooLarge
+	}
+
+	return nil
+}
+
+// gunzip decompresses the gzip stream.
+// An error is returned if the decompressed data exceeds gzipMaxDecompressedSize bytes.
+func gunzip(data []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, gzipMaxDecompressedSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > gzipMaxDecompressedSize {
+		return nil, errGzipTooLarge
+	}
+
+	return decompressed, nil
+}
+
+// isGzip reports whether the data starts with the gzip magic number.
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+func TestPEMValidator(t *testing.T) {
+	t.Parallel()
+
+	const block = "-----BEGIN TEST-----\ndGVzdA==\n-----END TEST-----\n"
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue(block),
+		},
+		"valid-multiple-blocks": {
+			val: types.StringValue(block + "\n" + block + "\n\n"),
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-not-pem": {
+			val:         types.StringValue("dGVzdA=="),
+			expectError: true,
+		},
+		"invalid-trailing-data": {
+			val:         types.StringValue(block + "garbage"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsPEM().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGzipValidator(t *testing.T) {
+	t.Parallel()
+
+	// echo -n 'test' | gzip -n
+	valid := string([]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x2b, 0x49, 0x2d, 0x2e,
+		0x01, 0x00, 0x0c, 0x7e, 0x7f, 0xd8, 0x04, 0x00, 0x00, 0x00,
+	})
+
+	compress := func(size int) string {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(make([]byte, size)); err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+		return buf.String()
+	}
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue(valid),
+		},
+		"invalid-not-gzip": {
+			val:         types.StringValue("test"),
+			expectError: true,
+		},
+		"invalid-truncated": {
+			val:         types.StringValue(valid[:len(valid)-6]),
+			expectError: true,
+		},
+		"valid-max-size": {
+			val: types.StringValue(compress(1 << 20)),
+		},
+		"invalid-too-large": {
+			// The decompressed content exceeds 1 MiB.
+			val:         types.StringValue(compress(1<<20 + 1)),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsGzip().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
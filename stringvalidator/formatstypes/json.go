@@ -0,0 +1,187 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = jsonValidator{}
+
+// JSONKind is the kind of a JSON value.
+type JSONKind string
+
+const (
+	// JSONKindObject is a JSON object ({...}).
+	JSONKindObject JSONKind = "object"
+	// JSONKindArray is a JSON array ([...]).
+	JSONKindArray JSONKind = "array"
+)
+
+// JSONParams is the configuration of the IsJSON validator.
+type JSONParams struct {
+	// Kind is the expected kind of the top-level value.
+	// If empty, any JSON value is accepted (object, array, string, number, boolean or null).
+	// Another value is reported as an invalid configuration.
+	Kind JSONKind
+	// MaxDepth is the maximum nesting depth of objects and arrays. Ignored if 0.
+	// The top-level object or array has a depth of 1.
+	MaxDepth int
+	// MaxSize is the maximum size in bytes of the document. Ignored if 0.
+	MaxSize int
+}
+
+func (p JSONParams) validateConfig() error {
+	switch p.Kind {
+	case "", JSONKindObject, JSONKindArray:
+		return nil
+	default:
+		return fmt.Errorf("unknown JSON kind %q (expected object or array)", p.Kind)
+	}
+}
+
+type jsonValidator struct {
+	params JSONParams
+	// err is the error returned by the check of the configuration by IsJSON.
+	err error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator jsonValidator) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jsonValidator) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+func (validator jsonValidator) description(format string) string {
+	description := "must be a valid JSON document"
+	if validator.params.Kind != "" {
+		description = fmt.Sprintf("must be a valid JSON "+format, validator.params.Kind)
+	}
+
+	var options []string
+	if validator.params.MaxDepth > 0 {
+		options = append(options, fmt.Sprintf("with a maximum depth of %d", validator.params.MaxDepth))
+	}
+	if validator.params.MaxSize > 0 {
+		options = append(options, fmt.Sprintf("with a maximum size of %d bytes", validator.params.MaxSize))
+	}
+
+	if len(options) > 0 {
+		description += " " + strings.Join(options, " and ")
+	}
+
+	return description
+}
+
+// Validate performs the validation.
+func (validator jsonValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	if err := validator.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse JSON",
+			fmt.Sprintf("The value is not a valid JSON document: %s", err),
+		)
+	}
+}
+
+func (validator jsonValidator) validate(value string) error {
+	if validator.params.MaxSize > 0 && len(value) > validator.params.MaxSize {
+		return fmt.Errorf("the document is %d bytes long, expected at most %d bytes", len(value), validator.params.MaxSize)
+	}
+
+	if !json.Valid([]byte(value)) {
+		// json.Valid does not return the reason, decode again to report it.
+		var v any
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return err
+		}
+		return errors.New("unexpected content")
+	}
+
+	// The document is well-formed, walk the tokens to check the kind and the depth.
+	decoder := json.NewDecoder(strings.NewReader(value))
+	depth := 0
+	first := true
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		delim, isDelim := token.(json.Delim)
+
+		if first {
+			first = false
+			if err := validator.checkKind(delim, isDelim); err != nil {
+				return err
+			}
+		}
+
+		if !isDelim {
+			continue
+		}
+
+		switch delim {
+		case '{', '[':
+			depth++
+			if validator.params.MaxDepth > 0 && depth > validator.params.MaxDepth {
+				return fmt.Errorf("the document exceeds the maximum depth of %d", validator.params.MaxDepth)
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+}
+
+func (validator jsonValidator) checkKind(delim json.Delim, isDelim bool) error {
+	switch validator.params.Kind {
+	case JSONKindObject:
+		if !isDelim || delim != '{' {
+			return errors.New("the top-level value must be an object")
+		}
+	case JSONKindArray:
+		if !isDelim || delim != '[' {
+			return errors.New("the top-level value must be an array")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+var _ validator.String = &jsonSchemaValidator{}
+
+const (
+	// jsonSchemaURL is the location of a schema given as a string.
+	jsonSchemaURL = "mem:///schema.json"
+	// jsonSchemaFSPrefix is the location prefix of the schemas loaded from a fs.FS.
+	// The relative $ref are resolved against the same file system.
+	jsonSchemaFSPrefix = "fs:///"
+)
+
+type jsonSchemaValidator struct {
+	schema *jsonschema.Schema
+	// err is the error returned by the compilation of the schema by IsJSONSchema or IsJSONSchemaFromFS.
+	// It is reported when the validator is used, use NewJSONSchema or NewJSONSchemaFromFS to get it at construction.
+	err error
+}
+
+// jsonSchemaFSLoader loads the schemas referenced with the fs:/// scheme from a fs.FS.
+type jsonSchemaFSLoader struct {
+	fsys fs.FS
+}
+
+func (l jsonSchemaFSLoader) Load(url string) (any, error) {
+	if !strings.HasPrefix(url, jsonSchemaFSPrefix) {
+		return nil, fmt.Errorf("unsupported schema location %q", url)
+	}
+
+	f, err := l.fsys.Open(strings.TrimPrefix(url, jsonSchemaFSPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return jsonschema.UnmarshalJSON(f)
+}
+
+// compileJSONSchema compiles the JSON schema given as a string.
+func compileJSONSchema(schema string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the JSON schema: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource(jsonSchemaURL, doc); err != nil {
+		return nil, err
+	}
+
+	sch, err := c.Compile(jsonSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the JSON schema: %w", err)
+	}
+
+	return sch, nil
+}
+
+// compileJSONSchemaFromFS compiles the JSON schema stored in the file name of fsys.
+func compileJSONSchemaFromFS(fsys fs.FS, name string) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonSchemaFSLoader{fsys: fsys})
+
+	sch, err := c.Compile(jsonSchemaFSPrefix + name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the JSON schema %s: %w", name, err)
+	}
+
+	return sch, nil
+}
+
+// Description describes the validation in plain text formatting.
+func (validator jsonSchemaValidator) Description(_ context.Context) string {
+	return "must be a valid JSON document matching the JSON schema"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jsonSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator jsonSchemaValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(request.ConfigValue.ValueString()))
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse JSON",
+			fmt.Sprintf("The value is not a valid JSON document: %s", err),
+		)
+		return
+	}
+
+	err = validator.schema.Validate(instance)
+	if err == nil {
+		return
+	}
+
+	var validationError *jsonschema.ValidationError
+	if !errors.As(err, &validationError) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"JSON schema validation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	violations := validationError.BasicOutput().Errors
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].InstanceLocation != violations[j].InstanceLocation {
+			return violations[i].InstanceLocation < violations[j].InstanceLocation
+		}
+		return violations[i].KeywordLocation < violations[j].KeywordLocation
+	})
+
+	for _, violation := range violations {
+		if violation.Error == nil {
+			continue
+		}
+
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"JSON schema violation",
+			fmt.Sprintf("The value at JSON pointer %q does not match the schema: %s", violation.InstanceLocation, violation.Error),
+		)
+	}
+}
@@ -0,0 +1,254 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes_test
+
+import (
+	"context"
+	"embed"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+//go:embed testdata/*.json
+var testdataFS embed.FS
+
+func TestJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		param       formatstypes.JSONParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-object": {
+			val: types.StringValue(`{"name": "test", "tags": ["a", "b"]}`),
+		},
+		"valid-scalar": {
+			val: types.StringValue(`"test"`),
+		},
+		"valid-kind-object": {
+			val: types.StringValue(`{"name": "test"}`),
+			param: formatstypes.JSONParams{
+				Kind: formatstypes.JSONKindObject,
+			},
+		},
+		"valid-kind-array": {
+			val: types.StringValue(` [1, 2] `),
+			param: formatstypes.JSONParams{
+				Kind: formatstypes.JSONKindArray,
+			},
+		},
+		"valid-max-depth": {
+			val: types.StringValue(`{"a": [1, 2], "b": {"c": 1}}`),
+			param: formatstypes.JSONParams{
+				MaxDepth: 2,
+			},
+		},
+		"invalid-syntax": {
+			val:         types.StringValue(`{"name": "test",}`),
+			expectError: true,
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-trailing-data": {
+			val:         types.StringValue(`{} {}`),
+			expectError: true,
+		},
+		"invalid-kind-object": {
+			val: types.StringValue(`[{"name": "test"}]`),
+			param: formatstypes.JSONParams{
+				Kind: formatstypes.JSONKindObject,
+			},
+			expectError: true,
+		},
+		"invalid-kind-array": {
+			val: types.StringValue(`"test"`),
+			param: formatstypes.JSONParams{
+				Kind: formatstypes.JSONKindArray,
+			},
+			expectError: true,
+		},
+		"invalid-max-depth": {
+			val: types.StringValue(`{"a": {"b": [1]}}`),
+			param: formatstypes.JSONParams{
+				MaxDepth: 2,
+			},
+			expectError: true,
+		},
+		"invalid-max-size": {
+			val: types.StringValue(`{"name": "` + strings.Repeat("a", 32) + `"}`),
+			param: formatstypes.JSONParams{
+				MaxSize: 32,
+			},
+			expectError: true,
+		},
+		"invalid-unknown-kind": {
+			// Valid JSON, the unknown kind is reported as an invalid configuration.
+			val: types.StringValue(`"test"`),
+			param: formatstypes.JSONParams{
+				Kind: "string",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsJSON(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestJSONValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := formatstypes.IsJSON(formatstypes.JSONParams{
+		Kind:     formatstypes.JSONKindObject,
+		MaxDepth: 4,
+		MaxSize:  1024,
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "must be a valid JSON object with a maximum depth of 4 and with a maximum size of 1024 bytes"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "must be a valid JSON `object` with a maximum depth of 4 and with a maximum size of 1024 bytes"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
+
+func TestJSONSchemaValidator(t *testing.T) {
+	t.Parallel()
+
+	const schema = `{
+		"type": "object",
+		"required": ["name"],
+		"properties": {
+			"name": {"type": "string"},
+			"port": {"type": "integer", "maximum": 65535},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`
+
+	type testCase struct {
+		val       types.String
+		validator validator.String
+		// expectErrors is the list of the JSON pointers expected in the diagnostics, in order.
+		expectErrors []string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:       types.StringUnknown(),
+			validator: formatstypes.IsJSONSchema(schema),
+		},
+		"null": {
+			val:       types.StringNull(),
+			validator: formatstypes.IsJSONSchema(schema),
+		},
+		"valid": {
+			val:       types.StringValue(`{"name": "test", "port": 443, "tags": ["a"]}`),
+			validator: formatstypes.IsJSONSchema(schema),
+		},
+		"invalid-violations": {
+			val:          types.StringValue(`{"port": 70000, "tags": ["a", 1]}`),
+			validator:    formatstypes.IsJSONSchema(schema),
+			expectErrors: []string{`""`, `"/port"`, `"/tags/1"`},
+		},
+		"invalid-json": {
+			val:          types.StringValue(`{"name": `),
+			validator:    formatstypes.IsJSONSchema(schema),
+			expectErrors: []string{""},
+		},
+		"invalid-schema": {
+			val:          types.StringValue(`{"name": "test"}`),
+			validator:    formatstypes.IsJSONSchema(`{"type": 1}`),
+			expectErrors: []string{""},
+		},
+		"valid-fs": {
+			val:       types.StringValue(`{"name": "test", "ports": [80, 443]}`),
+			validator: formatstypes.IsJSONSchemaFromFS(testdataFS, "testdata/schema.json"),
+		},
+		"invalid-fs-ref": {
+			val:          types.StringValue(`{"name": "test", "ports": [80, 0]}`),
+			validator:    formatstypes.IsJSONSchemaFromFS(testdataFS, "testdata/schema.json"),
+			expectErrors: []string{`"/ports/1"`},
+		},
+		"invalid-fs-missing-file": {
+			val:          types.StringValue(`{"name": "test"}`),
+			validator:    formatstypes.IsJSONSchemaFromFS(testdataFS, "testdata/missing.json"),
+			expectErrors: []string{""},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			test.validator.ValidateString(context.TODO(), request, &response)
+
+			errs := response.Diagnostics.Errors()
+			if len(errs) != len(test.expectErrors) {
+				t.Fatalf("expected %d errors, got %d: %s", len(test.expectErrors), len(errs), response.Diagnostics)
+			}
+			for i, pointer := range test.expectErrors {
+				if !strings.Contains(errs[i].Detail(), pointer) {
+					t.Errorf("expected error %d to contain %s, got %q", i, pointer, errs[i].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestNewJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	if _, err := formatstypes.NewJSONSchema(`{"type": "object"}`); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+	if _, err := formatstypes.NewJSONSchema(`{"type": 1}`); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+
+	if _, err := formatstypes.NewJSONSchemaFromFS(testdataFS, "testdata/schema.json"); err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+	if _, err := formatstypes.NewJSONSchemaFromFS(testdataFS, "testdata/missing.json"); err == nil {
+		t.Fatal("expected error, got no error")
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+var _ validator.String = typedURNValidator{}
+
+var (
+	// urnNIDRegex is the namespace identifier grammar defined by RFC 8141 section 2.
+	urnNIDRegex = common.MustCompileRegex(`^[A-Za-z0-9][A-Za-z0-9-]{0,30}[A-Za-z0-9]$`)
+	// urnEntityTypeRegex is the grammar of the entity type segment (Ex: vdc, edgeGateway, vdc-group).
+	urnEntityTypeRegex = common.MustCompileRegex(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+)
+
+// TypedURNParams is the configuration of the IsTypedURN validator.
+type TypedURNParams struct {
+	// NID is the expected namespace identifier (Ex: vcloud).
+	// The comparison is case-insensitive as defined by RFC 8141.
+	// If empty, any valid namespace identifier is accepted.
+	NID string
+	// EntityTypes is the list of allowed entity types (Ex: vdc, gateway).
+	// The comparison is case-sensitive.
+	// If empty, any valid entity type is accepted.
+	EntityTypes []string
+	// UUID is the configuration of the UUID validator applied to the trailing identifier.
+	UUID UUIDParams
+}
+
+type typedURNValidator struct {
+	params TypedURNParams
+	// err is the error returned by the check of the configuration by IsTypedURN.
+	err error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator typedURNValidator) Description(_ context.Context) string {
+	description := fmt.Sprintf("must be a valid URN in the form urn:%s:<entity_type>:<uuid>", validator.nidDescription())
+	if len(validator.params.EntityTypes) > 0 {
+		description += fmt.Sprintf(" where entity_type is one of %s", strings.Join(validator.params.EntityTypes, ", "))
+	}
+
+	return description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator typedURNValidator) MarkdownDescription(_ context.Context) string {
+	description := fmt.Sprintf("must be a valid URN in the form `urn:%s:<entity_type>:<uuid>`", validator.nidDescription())
+	if len(validator.params.EntityTypes) > 0 {
+		description += fmt.Sprintf(" where `entity_type` is one of `%s`", strings.Join(validator.params.EntityTypes, "`, `"))
+	}
+
+	return description
+}
+
+func (validator typedURNValidator) nidDescription() string {
+	if validator.params.NID == "" {
+		return "<nid>"
+	}
+
+	return validator.params.NID
+}
+
+// Validate performs the validation.
+func (validator typedURNValidator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	if err := validator.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse URN",
+			fmt.Sprintf("The value %s is not a valid URN: %s", request.ConfigValue.String(), err),
+		)
+	}
+}
+
+func (validator typedURNValidator) validate(value string) error {
+	// urn:<nid>:<entity_type>:<uuid>
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[0], "urn") {
+		return fmt.Errorf("expected the form urn:%s:<entity_type>:<uuid>", validator.nidDescription())
+	}
+
+	nid, nss := parts[1], parts[2]
+
+	switch {
+	case validator.params.NID != "" && !strings.EqualFold(nid, validator.params.NID):
+		return fmt.Errorf("the namespace identifier %q is not %q", nid, validator.params.NID)
+	case !urnNIDRegex.MatchString(nid):
+		return fmt.Errorf("the namespace identifier %q is not valid", nid)
+	}
+
+	i := strings.LastIndex(nss, ":")
+	if i < 0 {
+		return fmt.Errorf("expected the namespace specific string in the form <entity_type>:<uuid>, got %q", nss)
+	}
+
+	entityType, id := nss[:i], nss[i+1:]
+
+	if !urnEntityTypeRegex.MatchString(entityType) {
+		return fmt.Errorf("the entity type %q is not valid", entityType)
+	}
+
+	if len(validator.params.EntityTypes) > 0 {
+		allowed := false
+		for _, t := range validator.params.EntityTypes {
+			if t == entityType {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("the entity type %q is not one of %s", entityType, strings.Join(validator.params.EntityTypes, ", "))
+		}
+	}
+
+	if err := (uuidRFC9562Validator{params: validator.params.UUID}).validate(id); err != nil {
+		return fmt.Errorf("the identifier %q is not a valid UUID: %w", id, err)
+	}
+
+	return nil
+}
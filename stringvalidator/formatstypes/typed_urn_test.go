@@ -0,0 +1,179 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+func TestValidTypedURNValidator(t *testing.T) {
+	t.Parallel()
+
+	vcloud := formatstypes.TypedURNParams{
+		NID:         "vcloud",
+		EntityTypes: []string{"vdc", "gateway"},
+	}
+
+	type testCase struct {
+		val         types.String
+		params      formatstypes.TypedURNParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-any": {
+			val: types.StringValue("urn:test:demo:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+		},
+		"valid-vdc": {
+			val:    types.StringValue("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params: vcloud,
+		},
+		"valid-gateway": {
+			val:    types.StringValue("urn:vcloud:gateway:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params: vcloud,
+		},
+		"valid-nid-case-insensitive": {
+			val:    types.StringValue("URN:VCloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params: vcloud,
+		},
+		"valid-uuid-settings": {
+			val: types.StringValue("urn:vcloud:vdc:018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+			params: formatstypes.TypedURNParams{
+				NID: "vcloud",
+				UUID: formatstypes.UUIDParams{
+					Versions: []int{7},
+				},
+			},
+		},
+		"invalid-nid": {
+			val:         types.StringValue("urn:vmware:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params:      vcloud,
+			expectError: true,
+		},
+		"invalid-nid-syntax": {
+			val:         types.StringValue("urn:-test:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-entity-type": {
+			val:         types.StringValue("urn:vcloud:org:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params:      vcloud,
+			expectError: true,
+		},
+		"invalid-entity-type-empty": {
+			val:         types.StringValue("urn:vcloud::4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-missing-entity-type": {
+			val:         types.StringValue("urn:vcloud:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-identifier": {
+			val:         types.StringValue("urn:vcloud:vdc:not-a-uuid"),
+			params:      vcloud,
+			expectError: true,
+		},
+		"invalid-uuid-version": {
+			val: types.StringValue("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params: formatstypes.TypedURNParams{
+				NID: "vcloud",
+				UUID: formatstypes.UUIDParams{
+					Versions: []int{7},
+				},
+			},
+			expectError: true,
+		},
+		"invalid-prefix": {
+			val:         types.StringValue("id=urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params:      vcloud,
+			expectError: true,
+		},
+		"invalid-suffix": {
+			val:         types.StringValue("urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12 "),
+			params:      vcloud,
+			expectError: true,
+		},
+		"invalid-not-urn": {
+			val:         types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"multiple byte characters": {
+			// Rightwards Arrow Over Leftwards Arrow (U+21C4; 3 bytes)
+			val:         types.StringValue("⇄"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsTypedURN(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidTypedURNValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		params              formatstypes.TypedURNParams
+		description         string
+		markdownDescription string
+	}
+	tests := map[string]testCase{
+		"default": {
+			description:         "must be a valid URN in the form urn:<nid>:<entity_type>:<uuid>",
+			markdownDescription: "must be a valid URN in the form `urn:<nid>:<entity_type>:<uuid>`",
+		},
+		"vcloud": {
+			params: formatstypes.TypedURNParams{
+				NID:         "vcloud",
+				EntityTypes: []string{"vdc", "gateway"},
+			},
+			description:         "must be a valid URN in the form urn:vcloud:<entity_type>:<uuid> where entity_type is one of vdc, gateway",
+			markdownDescription: "must be a valid URN in the form `urn:vcloud:<entity_type>:<uuid>` where `entity_type` is one of `vdc`, `gateway`",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			validator := formatstypes.IsTypedURN(test.params)
+			if validator.Description(context.Background()) != test.description {
+				t.Fatalf("got unexpected description: %s != %s", validator.Description(context.Background()), test.description)
+			}
+			if validator.MarkdownDescription(context.Background()) != test.markdownDescription {
+				t.Fatalf("got unexpected markdown description: %s != %s", validator.MarkdownDescription(context.Background()), test.markdownDescription)
+			}
+		})
+	}
+}
@@ -28,6 +28,15 @@ import (
 
 var _ validator.String = &urnValidator{}
 
+// urnRegexValidator is compiled once, at package initialization.
+var urnRegexValidator = common.MustNewRegexValidator(common.RegexValidator{
+	Desc: "must be a valid URN",
+	// Anchored to the full value, a string that merely contains a URN is not a URN.
+	Regex:        `^urn:[A-Za-z0-9][A-Za-z0-9-]{0,31}:([A-Za-z0-9()+,\-.:=@;$_!*']|%[0-9A-Fa-f]{2})+$`,
+	ErrorSummary: "Failed to parse URN",
+	ErrorDetail:  "This value is not a valid URN",
+})
+
 type urnValidator struct{}
 
 // Description describes the validation in plain text formatting.
@@ -53,13 +62,7 @@ func (validator urnValidator) ValidateString(
 	// Use the common regex validator to validate the URN format
 	// and add the error message if it doesn't match
 	// the expected URN format.
-	regexValidator := common.RegexValidator{
-		Desc:         "must be a valid URN",
-		Regex:        `(?m)urn:[A-Za-z0-9][A-Za-z0-9-]{0,31}:([A-Za-z0-9()+,\-.:=@;$_!*']|%[0-9A-Fa-f]{2})+`,
-		ErrorSummary: "Failed to parse URN",
-		ErrorDetail:  "This value is not a valid URN",
-	}
-	regexValidator.ValidateString(ctx, request, response)
+	urnRegexValidator.ValidateString(ctx, request, response)
 	if response.Diagnostics.HasError() {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
@@ -28,6 +28,17 @@ import (
 
 var _ validator.String = uuidValidator{}
 
+// uuidRegexValidator is compiled once, at package initialization.
+var uuidRegexValidator = common.MustNewRegexValidator(common.RegexValidator{
+	Desc: "must be a valid UUID",
+	// UUID v4 regex pattern
+	// https://www.ietf.org/rfc/rfc9562.txt
+	// explain: https://www.bortzmeyer.org/9562.html
+	Regex:        `(?m)^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[89abAB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$`,
+	ErrorSummary: "Failed to parse UUID",
+	ErrorDetail:  "This value is not a valid (v4) UUID",
+})
+
 type uuidValidator struct{}
 
 // Description describes the validation in plain text formatting.
@@ -53,14 +64,5 @@ func (validator uuidValidator) ValidateString(
 	// Use the common regex validator to validate the UUID format
 	// and add the error message if it doesn't match
 	// the expected UUID format.
-	regexValidator := common.RegexValidator{
-		Desc: "must be a valid UUID",
-		// UUID v4 regex pattern
-		// https://www.ietf.org/rfc/rfc9562.txt
-		// explain: https://www.bortzmeyer.org/9562.html
-		Regex:        `(?m)^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[89abAB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$`,
-		ErrorSummary: "Failed to parse UUID",
-		ErrorDetail:  "This value is not a valid (v4) UUID",
-	}
-	regexValidator.ValidateString(ctx, request, response)
+	uuidRegexValidator.ValidateString(ctx, request, response)
 }
@@ -0,0 +1,205 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = uuidRFC9562Validator{}
+
+const (
+	uuidNil = "00000000-0000-0000-0000-000000000000"
+	uuidMax = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+
+	uuidURNPrefix = "urn:uuid:"
+)
+
+// UUIDParams is the configuration of the IsUUID validator.
+type UUIDParams struct {
+	// Versions is the list of allowed versions (1 to 8).
+	// If empty, all the versions defined by RFC 9562 are allowed.
+	Versions []int
+	// AllowNil allows the nil UUID (00000000-0000-0000-0000-000000000000).
+	AllowNil bool
+	// AllowMax allows the max UUID (ffffffff-ffff-ffff-ffff-ffffffffffff).
+	AllowMax bool
+	// LowerCaseOnly rejects the UUIDs containing uppercase hexadecimal characters.
+	LowerCaseOnly bool
+	// AllowBraces allows the Microsoft GUID form ({xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}).
+	AllowBraces bool
+	// AllowURN allows the URN form (urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx).
+	AllowURN bool
+	// AllowAnyVariant disables the check of the variant bits.
+	// By default, the variant must be the one defined by RFC 9562 (10xx).
+	AllowAnyVariant bool
+}
+
+func (p UUIDParams) validateConfig() error {
+	for _, version := range p.Versions {
+		if version < 1 || version > 8 {
+			return fmt.Errorf("invalid UUID version %d (expected 1 to 8)", version)
+		}
+	}
+
+	return nil
+}
+
+type uuidRFC9562Validator struct {
+	params UUIDParams
+	// err is the error returned by the check of the configuration by IsUUID.
+	err error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator uuidRFC9562Validator) Description(_ context.Context) string {
+	return validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator uuidRFC9562Validator) MarkdownDescription(_ context.Context) string {
+	return validator.description("`%s`")
+}
+
+func (validator uuidRFC9562Validator) description(format string) string {
+	description := "must be a valid UUID"
+
+	versions := validator.versions()
+	if len(versions) != 8 {
+		v := make([]string, 0, len(versions))
+		for _, version := range versions {
+			v = append(v, fmt.Sprintf(format, "v"+strconv.Itoa(version)))
+		}
+		description += " (" + strings.Join(v, ", ") + ")"
+	}
+
+	var options []string
+	if validator.params.LowerCaseOnly {
+		options = append(options, "in lowercase")
+	}
+	if validator.params.AllowNil {
+		options = append(options, "the nil UUID is allowed")
+	}
+	if validator.params.AllowMax {
+		options = append(options, "the max UUID is allowed")
+	}
+	if validator.params.AllowBraces {
+		options = append(options, fmt.Sprintf("the "+format+" form is allowed", "{...}"))
+	}
+	if validator.params.AllowURN {
+		options = append(options, fmt.Sprintf("the "+format+" form is allowed", uuidURNPrefix+"..."))
+	}
+
+	if len(options) > 0 {
+		description += ", " + strings.Join(options, ", ")
+	}
+
+	return description
+}
+
+func (validator uuidRFC9562Validator) versions() []int {
+	if len(validator.params.Versions) == 0 {
+		return []int{1, 2, 3, 4, 5, 6, 7, 8}
+	}
+
+	return validator.params.Versions
+}
+
+// Validate performs the validation.
+func (validator uuidRFC9562Validator) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	if err := validator.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse UUID",
+			fmt.Sprintf("The value %s is not a valid UUID: %s", request.ConfigValue.String(), err),
+		)
+	}
+}
+
+func (validator uuidRFC9562Validator) validate(value string) error {
+	switch {
+	case validator.params.AllowURN && len(value) >= len(uuidURNPrefix) && strings.EqualFold(value[:len(uuidURNPrefix)], uuidURNPrefix):
+		value = value[len(uuidURNPrefix):]
+	case validator.params.AllowBraces && strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}"):
+		value = value[1 : len(value)-1]
+	}
+
+	if len(value) != len(uuidNil) {
+		return fmt.Errorf("expected %d characters in the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, got %d", len(uuidNil), len(value))
+	}
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case i == 8 || i == 13 || i == 18 || i == 23:
+			if c != '-' {
+				return fmt.Errorf("expected a hyphen at position %d", i)
+			}
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f':
+		case c >= 'A' && c <= 'F':
+			if validator.params.LowerCaseOnly {
+				return fmt.Errorf("uppercase character %q at position %d is not allowed", c, i)
+			}
+		default:
+			return fmt.Errorf("invalid hexadecimal character %q at position %d", c, i)
+		}
+	}
+
+	switch strings.ToLower(value) {
+	case uuidNil:
+		if !validator.params.AllowNil {
+			return fmt.Errorf("the nil UUID is not allowed")
+		}
+		return nil
+	case uuidMax:
+		if !validator.params.AllowMax {
+			return fmt.Errorf("the max UUID is not allowed")
+		}
+		return nil
+	}
+
+	// The variant is held by the most significant bits of the 17th hexadecimal digit.
+	// RFC 9562 UUIDs use the variant 10xx (8, 9, a or b).
+	if !validator.params.AllowAnyVariant && !strings.ContainsRune("89abAB", rune(value[19])) {
+		return fmt.Errorf("the variant %q is not the one defined by RFC 9562 (8, 9, a or b)", value[19])
+	}
+
+	// The version is held by the 13th hexadecimal digit.
+	version := int(value[14] - '0')
+	for _, v := range validator.versions() {
+		if v == version {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the version %q is not allowed", value[14])
+}
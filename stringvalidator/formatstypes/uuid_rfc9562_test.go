@@ -0,0 +1,244 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+func TestValidUUIDRFC9562Validator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		params      formatstypes.UUIDParams
+		expectError bool
+		wantSummary string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-v1": {
+			val: types.StringValue("c232ab00-9414-11ec-b3c8-9f6bdeced846"),
+		},
+		"valid-v4": {
+			val: types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583b12"),
+		},
+		"valid-v7": {
+			val: types.StringValue("018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+		},
+		"valid-v8": {
+			val: types.StringValue("2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"),
+		},
+		"valid-uppercase": {
+			val: types.StringValue("4AEB40D8-038C-4E77-8181-A7054F583B12"),
+		},
+		"valid-version-allowed": {
+			val: types.StringValue("018f6b6e-3c1a-7d2e-9b4f-1a2b3c4d5e6f"),
+			params: formatstypes.UUIDParams{
+				Versions: []int{1, 7},
+			},
+		},
+		"valid-nil-allowed": {
+			val: types.StringValue("00000000-0000-0000-0000-000000000000"),
+			params: formatstypes.UUIDParams{
+				AllowNil: true,
+			},
+		},
+		"valid-max-allowed": {
+			val: types.StringValue("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF"),
+			params: formatstypes.UUIDParams{
+				AllowMax: true,
+			},
+		},
+		"valid-braces": {
+			val: types.StringValue("{4aeb40d8-038c-4e77-8181-a7054f583b12}"),
+			params: formatstypes.UUIDParams{
+				AllowBraces: true,
+			},
+		},
+		"valid-urn": {
+			val: types.StringValue("urn:uuid:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			params: formatstypes.UUIDParams{
+				AllowURN: true,
+			},
+		},
+		"valid-any-variant": {
+			val: types.StringValue("4aeb40d8-038c-4e77-c181-a7054f583b12"),
+			params: formatstypes.UUIDParams{
+				AllowAnyVariant: true,
+			},
+		},
+		"valid-v2": {
+			val: types.StringValue("000003e8-9414-21ec-8000-325096b39f47"),
+			params: formatstypes.UUIDParams{
+				Versions: []int{2},
+			},
+		},
+		"invalid-version-not-allowed": {
+			val: types.StringValue("c232ab00-9414-11ec-b3c8-9f6bdeced846"),
+			params: formatstypes.UUIDParams{
+				Versions: []int{4, 7},
+			},
+			expectError: true,
+		},
+		"invalid-version-0": {
+			val:         types.StringValue("4aeb40d8-038c-0e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-version-9": {
+			val:         types.StringValue("4aeb40d8-038c-9e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-variant": {
+			val:         types.StringValue("4aeb40d8-038c-4e77-c181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-nil": {
+			val:         types.StringValue("00000000-0000-0000-0000-000000000000"),
+			expectError: true,
+		},
+		"invalid-max": {
+			val:         types.StringValue("ffffffff-ffff-ffff-ffff-ffffffffffff"),
+			expectError: true,
+		},
+		"invalid-uppercase": {
+			val: types.StringValue("4AEB40D8-038C-4E77-8181-A7054F583B12"),
+			params: formatstypes.UUIDParams{
+				LowerCaseOnly: true,
+			},
+			expectError: true,
+		},
+		"invalid-underscore": {
+			val:         types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583b_2"),
+			expectError: true,
+		},
+		"invalid-non-hex": {
+			val:         types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583bzz"),
+			expectError: true,
+		},
+		"invalid-hyphens": {
+			val:         types.StringValue("4aeb40d8038c-4e77-8181-a7054f583b12-"),
+			expectError: true,
+		},
+		"invalid-braces-not-allowed": {
+			val:         types.StringValue("{4aeb40d8-038c-4e77-8181-a7054f583b12}"),
+			expectError: true,
+		},
+		"invalid-urn-not-allowed": {
+			val:         types.StringValue("urn:uuid:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-too-short": {
+			val:         types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583b"),
+			expectError: true,
+		},
+		"invalid-configuration-version-9": {
+			val: types.StringValue("4aeb40d8-038c-9e77-8181-a7054f583b12"),
+			params: formatstypes.UUIDParams{
+				Versions: []int{9},
+			},
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"invalid-configuration-version-0": {
+			val: types.StringValue("4aeb40d8-038c-0e77-8181-a7054f583b12"),
+			params: formatstypes.UUIDParams{
+				Versions: []int{0},
+			},
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"multiple byte characters": {
+			// Rightwards Arrow Over Leftwards Arrow (U+21C4; 3 bytes)
+			val:         types.StringValue("⇄"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsUUID(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantSummary != "" && response.Diagnostics[0].Summary() != test.wantSummary {
+				t.Fatalf("expected summary %q, got %q", test.wantSummary, response.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestValidUUIDRFC9562ValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		params              formatstypes.UUIDParams
+		description         string
+		markdownDescription string
+	}
+	tests := map[string]testCase{
+		"default": {
+			description:         "must be a valid UUID",
+			markdownDescription: "must be a valid UUID",
+		},
+		"versions": {
+			params: formatstypes.UUIDParams{
+				Versions: []int{4, 7},
+			},
+			description:         "must be a valid UUID (v4, v7)",
+			markdownDescription: "must be a valid UUID (`v4`, `v7`)",
+		},
+		"options": {
+			params: formatstypes.UUIDParams{
+				Versions:      []int{7},
+				LowerCaseOnly: true,
+				AllowNil:      true,
+				AllowURN:      true,
+			},
+			description:         "must be a valid UUID (v7), in lowercase, the nil UUID is allowed, the urn:uuid:... form is allowed",
+			markdownDescription: "must be a valid UUID (`v7`), in lowercase, the nil UUID is allowed, the `urn:uuid:...` form is allowed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			validator := formatstypes.IsUUID(test.params)
+			if validator.Description(context.Background()) != test.description {
+				t.Fatalf("got unexpected description: %s != %s", validator.Description(context.Background()), test.description)
+			}
+			if validator.MarkdownDescription(context.Background()) != test.markdownDescription {
+				t.Fatalf("got unexpected markdown description: %s != %s", validator.MarkdownDescription(context.Background()), test.markdownDescription)
+			}
+		})
+	}
+}
@@ -18,7 +18,11 @@
 
 package formatstypes
 
-import "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+import (
+	"io/fs"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
 
 /*
 IsBase64 returns a validator which ensures that the configured attribute
@@ -27,7 +31,76 @@ value is a valid base64 string with base64.StdEncoding package.
 Null (unconfigured) and unknown (known after apply) values are skipped.
 */
 func IsBase64() validator.String {
-	return &base64Validator{}
+	return &encodedValidator{
+		params: EncodedParams{
+			Encodings: []Encoding{EncodingBase64},
+		},
+	}
+}
+
+/*
+IsBase32 returns a validator which ensures that the configured attribute
+value is a valid base32 string with base32.StdEncoding package.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsBase32() validator.String {
+	return &encodedValidator{
+		params: EncodedParams{
+			Encodings: []Encoding{EncodingBase32},
+		},
+	}
+}
+
+/*
+IsHex returns a validator which ensures that the configured attribute
+value is a valid hexadecimal string with hex.DecodeString package.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsHex() validator.String {
+	return &encodedValidator{
+		params: EncodedParams{
+			Encodings: []Encoding{EncodingHex},
+		},
+	}
+}
+
+/*
+IsEncoded returns a validator which ensures that the configured attribute
+value can be decoded with at least one of the given encodings
+(base64, base64 URL-safe, unpadded base64, base32, hex, ...).
+
+The settings allow to bound the length of the decoded value and to apply
+a validator to the decoded value (Ex: IsPEM(), IsGzip()).
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsEncoded(settings EncodedParams) validator.String {
+	return &encodedValidator{
+		params: settings,
+	}
+}
+
+/*
+IsPEM returns a validator which ensures that the configured attribute
+value contains one or more PEM blocks and nothing else but whitespaces.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsPEM() validator.String {
+	return &pemValidator{}
+}
+
+/*
+IsGzip returns a validator which ensures that the configured attribute
+value is a valid gzip stream.
+The decompressed content must not exceed 1 MiB.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsGzip() validator.String {
+	return &gzipValidator{}
 }
 
 /*
@@ -49,3 +122,150 @@ Null (unconfigured) and unknown (known after apply) values are skipped.
 func IsURN() validator.String {
 	return &urnValidator{}
 }
+
+/*
+IsUUID returns a validator which ensures that the configured attribute
+value is a valid UUID as defined by RFC 9562.
+
+The settings allow to restrict the versions (1 to 8), to allow the nil
+and max UUIDs, to enforce lowercase and to accept the braces or URN forms.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsUUID(settings UUIDParams) validator.String {
+	return &uuidRFC9562Validator{
+		params: settings,
+		err:    settings.validateConfig(),
+	}
+}
+
+/*
+IsTypedURN returns a validator which ensures that the configured attribute
+value is a typed URN in the form urn:<nid>:<entity_type>:<uuid>
+(Ex: urn:vcloud:vdc:4aeb40d8-038c-4e77-8181-a7054f583b12).
+
+The settings allow to restrict the namespace identifier and the entity types.
+The trailing identifier is checked with the IsUUID validator.
+The whole value must match, a string that merely contains a URN is rejected.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsTypedURN(settings TypedURNParams) validator.String {
+	return &typedURNValidator{
+		params: settings,
+		err:    settings.UUID.validateConfig(),
+	}
+}
+
+/*
+IsJSON returns a validator which ensures that the configured attribute
+value is a well-formed JSON document.
+
+The settings allow to require a top-level object or array and to limit
+the nesting depth and the size of the document.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsJSON(settings JSONParams) validator.String {
+	return &jsonValidator{
+		params: settings,
+		err:    settings.validateConfig(),
+	}
+}
+
+/*
+IsJSONSchema returns a validator which ensures that the configured attribute
+value is a JSON document matching the given JSON schema.
+
+The schema is compiled once when the validator is created. If the schema
+is invalid, an error is returned when the validator is used. Use NewJSONSchema
+to get this error when the validator is created (Ex: in a unit test of the provider).
+Each violation is reported in its own diagnostic with the JSON pointer of
+the offending value (Ex: /spec/ports/0).
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsJSONSchema(schema string) validator.String {
+	sch, err := compileJSONSchema(schema)
+
+	return &jsonSchemaValidator{
+		schema: sch,
+		err:    err,
+	}
+}
+
+/*
+NewJSONSchema is like IsJSONSchema but returns an error if the schema is invalid.
+*/
+func NewJSONSchema(schema string) (validator.String, error) {
+	sch, err := compileJSONSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonSchemaValidator{
+		schema: sch,
+	}, nil
+}
+
+/*
+IsJSONSchemaFromFS returns a validator which ensures that the configured attribute
+value is a JSON document matching the JSON schema stored in the file name
+of fsys (Ex: an embed.FS).
+
+The relative references ($ref) of the schema are resolved against fsys.
+See IsJSONSchema for the details.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsJSONSchemaFromFS(fsys fs.FS, name string) validator.String {
+	sch, err := compileJSONSchemaFromFS(fsys, name)
+
+	return &jsonSchemaValidator{
+		schema: sch,
+		err:    err,
+	}
+}
+
+/*
+NewJSONSchemaFromFS is like IsJSONSchemaFromFS but returns an error if the schema
+cannot be read or is invalid.
+*/
+func NewJSONSchemaFromFS(fsys fs.FS, name string) (validator.String, error) {
+	sch, err := compileJSONSchemaFromFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonSchemaValidator{
+		schema: sch,
+	}, nil
+}
+
+/*
+IsYAML returns a validator which ensures that the configured attribute
+value is a well-formed YAML stream (one or more documents).
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsYAML() validator.String {
+	return &yamlValidator{}
+}
+
+/*
+IsCloudInit returns a validator which ensures that the configured attribute
+value is a valid cloud-init user-data.
+
+The user-data must start with the #cloud-config header, a shebang (#!) or
+be a MIME multipart archive. The cloud-config documents must be well-formed
+YAML mappings and their top-level keys must be handled by a cloud-config
+module. The value may be gzip compressed and base64 encoded, the size limit
+applies to the value as sent to the platform.
+
+Null (unconfigured) and unknown (known after apply) values are skipped.
+*/
+func IsCloudInit(settings CloudInitParams) validator.String {
+	return &cloudInitValidator{
+		params: settings,
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package formatstypes_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+func TestYAMLValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-mapping": {
+			val: types.StringValue("name: test\ntags:\n  - a\n  - b\n"),
+		},
+		"valid-multiple-documents": {
+			val: types.StringValue("name: a\n---\nname: b\n"),
+		},
+		"invalid-indentation": {
+			val:         types.StringValue("name: test\n  tags: a\n"),
+			expectError: true,
+		},
+		"invalid-tab": {
+			val:         types.StringValue("name:\n\t- a\n"),
+			expectError: true,
+		},
+		"invalid-unclosed-quote": {
+			val:         types.StringValue("name: \"test\n"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsYAML().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCloudInitValidator(t *testing.T) {
+	t.Parallel()
+
+	const multipart = "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"--BOUNDARY\r\n" +
+		"Content-Type: text/x-shellscript\r\n" +
+		"\r\n" +
+		"#!/bin/sh\r\necho hello\r\n" +
+		"--BOUNDARY\r\n" +
+		"Content-Type: text/cloud-config\r\n" +
+		"\r\n" +
+		"%s\r\n" +
+		"--BOUNDARY--\r\n"
+
+	type testCase struct {
+		val         types.String
+		param       formatstypes.CloudInitParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-cloud-config": {
+			val: types.StringValue("#cloud-config\npackages:\n  - nginx\nruncmd:\n  - [systemctl, start, nginx]\n"),
+		},
+		"valid-cloud-config-empty": {
+			val: types.StringValue("#cloud-config\n"),
+		},
+		"valid-shebang": {
+			val: types.StringValue("#!/bin/bash\necho hello\n"),
+		},
+		"valid-gzip-base64": {
+			// printf '#cloud-config\npackages:\n  - nginx\n' | gzip -n | base64
+			val: types.StringValue("H4sIAAAAAAAAA1NOzskvTdFNzs9Ly0znKkhMzk5MTy224lJQ0FXIS8/Mq+ACAL8fHCoiAAAA"),
+		},
+		"valid-base64": {
+			// printf '#cloud-config\nhostname: test\n' | base64
+			val: types.StringValue("I2Nsb3VkLWNvbmZpZwpob3N0bmFtZTogdGVzdAo="),
+		},
+		"valid-multipart": {
+			val: types.StringValue(strings.Replace(multipart, "%s", "#cloud-config\r\nhostname: test", 1)),
+		},
+		"valid-allowed-key": {
+			val: types.StringValue("#cloud-config\nmy_module: true\n"),
+			param: formatstypes.CloudInitParams{
+				AllowedKeys: []string{"my_module"},
+			},
+		},
+		"valid-allow-unknown-keys": {
+			val: types.StringValue("#cloud-config\nmy_module: true\n"),
+			param: formatstypes.CloudInitParams{
+				AllowUnknownKeys: true,
+			},
+		},
+		"invalid-missing-header": {
+			val:         types.StringValue("packages:\n  - nginx\n"),
+			expectError: true,
+		},
+		"invalid-yaml": {
+			val:         types.StringValue("#cloud-config\npackages:\n  - nginx\n runcmd: []\n"),
+			expectError: true,
+		},
+		"invalid-not-mapping": {
+			val:         types.StringValue("#cloud-config\n- nginx\n"),
+			expectError: true,
+		},
+		"invalid-unknown-key": {
+			val:         types.StringValue("#cloud-config\npackage:\n  - nginx\n"),
+			expectError: true,
+		},
+		"invalid-multipart-unknown-key": {
+			val:         types.StringValue(strings.Replace(multipart, "%s", "#cloud-config\r\nhost_name: test", 1)),
+			expectError: true,
+		},
+		"invalid-multipart-not-multipart": {
+			val:         types.StringValue("Content-Type: text/plain\r\n\r\nhello\r\n"),
+			expectError: true,
+		},
+		"invalid-gzip": {
+			// Truncated gzip stream
+			val:         types.StringValue("H4sIAAAAAAAAA1NOzskvTdFNzs9Ly0znKkhMzk5MTy224lJQ0FXIS8/M"),
+			expectError: true,
+		},
+		"invalid-size": {
+			val: types.StringValue("#!/bin/sh\n" + strings.Repeat("#", 64)),
+			param: formatstypes.CloudInitParams{
+				MaxSize: 64,
+			},
+			expectError: true,
+		},
+		"invalid-default-size": {
+			val:         types.StringValue("#!/bin/sh\n" + strings.Repeat("#", formatstypes.CloudInitDefaultMaxSize)),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			formatstypes.IsCloudInit(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = httpMethod{}
+
+// httpStandardMethods is the list of methods defined by RFC 9110 and RFC 5789 (PATCH).
+var httpStandardMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
+type httpMethod struct {
+	allowedMethods []string
+	err            error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator httpMethod) Description(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	if len(validator.allowedMethods) == 1 {
+		return fmt.Sprintf("The allowed HTTP method is %s", validator.allowedMethods[0])
+	}
+
+	return fmt.Sprintf("The following HTTP methods are allowed: %s", strings.Join(validator.allowedMethods, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator httpMethod) MarkdownDescription(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	if len(validator.allowedMethods) == 1 {
+		return fmt.Sprintf("The allowed HTTP method is `%s`", validator.allowedMethods[0])
+	}
+
+	return fmt.Sprintf("The following HTTP methods are allowed: `%s`", strings.Join(validator.allowedMethods, "`, `"))
+}
+
+// Validate performs the validation.
+func (validator httpMethod) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	method := request.ConfigValue.ValueString()
+
+	// A method is a token (RFC 9110 section 9.1)
+	if !internal.IsHTTPToken(method) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid HTTP method",
+			fmt.Sprintf("The value %s is not a valid HTTP method defined by the HTTP RFC9110", request.ConfigValue.String()),
+		)
+		return
+	}
+
+	// The method token is case-sensitive (RFC 9110 section 9.1)
+	for _, m := range validator.allowedMethods {
+		if m == method {
+			return
+		}
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid HTTP method",
+		fmt.Sprintf("The value %s is not in the allowed HTTP methods (%s)", request.ConfigValue.String(), strings.Join(validator.allowedMethods, ", ")),
+	)
+}
+
+type HTTPMethodParams struct {
+	// AllowedMethods is the list of allowed methods (Ex: GET, POST).
+	// Custom methods (Ex: PURGE) are accepted as long as they respect the token grammar,
+	// an invalid entry is reported as an invalid configuration.
+	// If empty, all the standard methods defined by RFC 9110 and RFC 5789 are allowed.
+	AllowedMethods []string
+}
+
+// HTTPMethod validates that a string represents a valid HTTP method.
+//
+// Parameters:
+//   - settings: HTTPMethodParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is an allowed HTTP method.
+func HTTPMethod(settings HTTPMethodParams) validator.String {
+	allowedMethods := settings.AllowedMethods
+	if len(allowedMethods) == 0 {
+		allowedMethods = httpStandardMethods
+	}
+
+	v := &httpMethod{
+		allowedMethods: allowedMethods,
+	}
+
+	for _, m := range allowedMethods {
+		if !internal.IsHTTPToken(m) {
+			v.err = fmt.Errorf("the allowed method %q is not a valid HTTP method token (RFC 9110 section 9.1)", m)
+			break
+		}
+	}
+
+	return v
+}
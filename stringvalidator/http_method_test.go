@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidHTTPMethodValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.HTTPMethodParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-standard": {
+			val: types.StringValue("PATCH"),
+		},
+		"valid-allowlist": {
+			val: types.StringValue("GET"),
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET", "HEAD"},
+			},
+		},
+		"valid-custom": {
+			val: types.StringValue("PURGE"),
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET", "PURGE"},
+			},
+		},
+		"invalid-not-in-allowlist": {
+			val: types.StringValue("POST"),
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET", "HEAD"},
+			},
+			expectError: true,
+		},
+		"invalid-custom-not-in-standard": {
+			val:         types.StringValue("PURGE"),
+			expectError: true,
+		},
+		"invalid-case": {
+			val:         types.StringValue("get"),
+			expectError: true,
+		},
+		"invalid-token": {
+			val:         types.StringValue("GET POST"),
+			expectError: true,
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"multiple byte characters": {
+			// Rightwards Arrow Over Leftwards Arrow (U+21C4; 3 bytes)
+			val:         types.StringValue("⇄"),
+			expectError: true,
+		},
+		"invalid-allowlist-token": {
+			val: types.StringValue("GET"),
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET", "GE T"},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.HTTPMethod(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidHTTPMethodValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		description         string
+		markdownDescription string
+		param               stringvalidator.HTTPMethodParams
+	}
+	tests := map[string]testCase{
+		"only-get": {
+			description:         "The allowed HTTP method is GET",
+			markdownDescription: "The allowed HTTP method is `GET`",
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET"},
+			},
+		},
+		"multiple-methods": {
+			description:         "The following HTTP methods are allowed: GET, POST",
+			markdownDescription: "The following HTTP methods are allowed: `GET`, `POST`",
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GET", "POST"},
+			},
+		},
+		"standard-methods": {
+			description:         "The following HTTP methods are allowed: GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE",
+			markdownDescription: "The following HTTP methods are allowed: `GET`, `HEAD`, `POST`, `PUT`, `PATCH`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`",
+			param:               stringvalidator.HTTPMethodParams{},
+		},
+		"invalid-configuration": {
+			description:         "invalid configuration",
+			markdownDescription: "invalid configuration",
+			param: stringvalidator.HTTPMethodParams{
+				AllowedMethods: []string{"GE T"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			validator := stringvalidator.HTTPMethod(test.param)
+			if validator.Description(context.Background()) != test.description {
+				t.Fatalf("got unexpected description: %s != %s", validator.Description(context.Background()), test.description)
+			}
+			if validator.MarkdownDescription(context.Background()) != test.markdownDescription {
+				t.Fatalf("got unexpected markdown description: %s != %s", validator.MarkdownDescription(context.Background()), test.markdownDescription)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/rivo/uniseg"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+var _ validator.String = length{}
+
+type LengthUnit string
+
+const (
+	// LengthUnitRunes counts the Unicode code points (Ex: é is 1, 👍🏽 is 2).
+	LengthUnitRunes LengthUnit = ""
+	// LengthUnitBytes counts the bytes of the UTF-8 encoding (Ex: é is 2, 👍🏽 is 8).
+	LengthUnitBytes LengthUnit = "bytes"
+	// LengthUnitUTF16 counts the UTF-16 code units (Ex: é is 1, 👍🏽 is 4).
+	LengthUnitUTF16 LengthUnit = "utf16"
+	// LengthUnitGraphemes counts the user-perceived characters, the extended grapheme clusters of UAX #29 (Ex: é is 1, 👍🏽 is 1).
+	LengthUnitGraphemes LengthUnit = "graphemes"
+)
+
+var lengthUnitNames = map[LengthUnit]string{
+	LengthUnitRunes:     "characters",
+	LengthUnitBytes:     "bytes",
+	LengthUnitUTF16:     "UTF-16 code units",
+	LengthUnitGraphemes: "grapheme clusters",
+}
+
+// count returns the length of the value in the unit.
+func (u LengthUnit) count(value string) int {
+	switch u {
+	case LengthUnitBytes:
+		return len(value)
+	case LengthUnitUTF16:
+		n := 0
+		for _, r := range value {
+			// The runes outside of the Basic Multilingual Plane are encoded as a surrogate pair.
+			if r >= 0x10000 {
+				n += 2
+			} else {
+				n++
+			}
+		}
+		return n
+	case LengthUnitGraphemes:
+		return uniseg.GraphemeClusterCount(value)
+	default:
+		return utf8.RuneCountInString(value)
+	}
+}
+
+type LengthParams struct {
+	// Unit is the unit of the length. Default is LengthUnitRunes.
+	Unit LengthUnit
+	// Min is the minimum length. If 0, there is no lower bound.
+	Min int
+	// Max is the maximum length. If 0, there is no upper bound.
+	Max int
+}
+
+func (p LengthParams) validateConfig() error {
+	if _, ok := lengthUnitNames[p.Unit]; !ok {
+		return fmt.Errorf("unknown length unit %q (expected bytes, utf16 or graphemes)", p.Unit)
+	}
+
+	if p.Min < 0 || p.Max < 0 || (p.Max > 0 && p.Min > p.Max) {
+		return fmt.Errorf("invalid length bounds: min %d, max %d", p.Min, p.Max)
+	}
+
+	return nil
+}
+
+func (p LengthParams) description(format string) string {
+	unit := lengthUnitNames[p.Unit]
+	bound := func(n int) string {
+		return fmt.Sprintf(format, strconv.Itoa(n))
+	}
+
+	switch {
+	case p.Min > 0 && p.Max > 0:
+		return fmt.Sprintf("a string of %s to %s %s", bound(p.Min), bound(p.Max), unit)
+	case p.Min > 0:
+		return fmt.Sprintf("a string of at least %s %s", bound(p.Min), unit)
+	case p.Max > 0:
+		return fmt.Sprintf("a string of at most %s %s", bound(p.Max), unit)
+	default:
+		return "a string"
+	}
+}
+
+// LengthTransform is a transformation of the value applied before measuring its length.
+type LengthTransform struct {
+	description func(format string) string
+	apply       func(value string) (string, error)
+}
+
+// LengthTransformTrimSpace removes the leading and trailing whitespaces.
+func LengthTransformTrimSpace() LengthTransform {
+	return LengthTransform{
+		description: func(_ string) string {
+			return "removing the leading and trailing whitespaces"
+		},
+		apply: func(value string) (string, error) {
+			return strings.TrimSpace(value), nil
+		},
+	}
+}
+
+// LengthTransformDecode decodes the value with the encoding (Ex: formatstypes.EncodingBase64).
+// The length of the decoded value is usually measured in bytes (LengthUnitBytes).
+func LengthTransformDecode(encoding formatstypes.Encoding) LengthTransform {
+	return LengthTransform{
+		description: func(format string) string {
+			return fmt.Sprintf("decoding the %s value", fmt.Sprintf(format, encoding))
+		},
+		apply: func(value string) (string, error) {
+			decoded, err := encoding.Decode(value)
+			if err != nil {
+				return "", fmt.Errorf("the value is not a valid %s string: %w", encoding, err)
+			}
+			return string(decoded), nil
+		},
+	}
+}
+
+type length struct {
+	params     LengthParams
+	transforms []LengthTransform
+	err        error
+}
+
+func newLength(settings LengthParams, transforms []LengthTransform) *length {
+	return &length{
+		params:     settings,
+		transforms: transforms,
+		err:        validateLengthConfig(settings, transforms),
+	}
+}
+
+func validateLengthConfig(settings LengthParams, transforms []LengthTransform) error {
+	if err := settings.validateConfig(); err != nil {
+		return err
+	}
+
+	for i, transform := range transforms {
+		// The zero value of LengthTransform has no transformation to apply.
+		if transform.apply == nil {
+			return fmt.Errorf("the transform %d is not initialized (use LengthTransformTrimSpace or LengthTransformDecode)", i)
+		}
+	}
+
+	return nil
+}
+
+func (validator length) description(format string) string {
+	description := validator.params.description(format)
+
+	if len(validator.transforms) > 0 {
+		transforms := make([]string, 0, len(validator.transforms))
+		for _, transform := range validator.transforms {
+			transforms = append(transforms, transform.description(format))
+		}
+		description += " after " + strings.Join(transforms, " and ")
+	}
+
+	return description
+}
+
+// Description describes the validation in plain text formatting.
+func (validator length) Description(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator length) MarkdownDescription(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator length) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	for _, transform := range validator.transforms {
+		var err error
+		if value, err = transform.apply(value); err != nil {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid value",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	n := validator.params.Unit.count(value)
+	if (validator.params.Min > 0 && n < validator.params.Min) || (validator.params.Max > 0 && n > validator.params.Max) {
+		measured := "The value"
+		if len(validator.transforms) > 0 {
+			measured = "The transformed value"
+		}
+
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid length",
+			fmt.Sprintf("%s has a length of %d %s, it must be %s", measured, n, lengthUnitNames[validator.params.Unit], validator.description("%s")),
+		)
+	}
+}
+
+// Length validates that the length of a string, measured in the given unit, is between the bounds.
+//
+// The unit matters for the values with accents or emoji: a backend limiting the names
+// to 64 bytes rejects 40 accented characters, that are 80 bytes long in UTF-8.
+//
+// Parameters:
+//   - settings: LengthParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks the length of the string.
+func Length(settings LengthParams) validator.String {
+	return newLength(settings, nil)
+}
+
+// TransformedLength validates the length of a string after applying the transforms in order
+// (Ex: the size in bytes of a base64 encoded value, with LengthTransformDecode).
+//
+// If a transform fails (Ex: the value is not a valid base64 string), an error is returned.
+// A zero LengthTransform is reported as an invalid configuration.
+//
+// Parameters:
+//   - settings: LengthParams containing the configuration for the validator.
+//   - transforms: The transforms applied to the value before measuring its length.
+//
+// Returns:
+//   - validator.String: A validator that checks the length of the transformed string.
+func TransformedLength(settings LengthParams, transforms ...LengthTransform) validator.String {
+	return newLength(settings, transforms)
+}
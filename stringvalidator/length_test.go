@@ -0,0 +1,243 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/formatstypes"
+)
+
+func TestValidLengthValidator(t *testing.T) {
+	t.Parallel()
+
+	// "thumbs up, medium skin tone" is 1 grapheme cluster, 2 runes, 4 UTF-16 code units and 8 bytes.
+	const thumbsUp = "👍🏽"
+	// "family: man, woman, girl" is 1 grapheme cluster, 5 runes, 8 UTF-16 code units and 18 bytes.
+	const family = "👨‍👩‍👧"
+
+	type testCase struct {
+		val         types.String
+		params      stringvalidator.LengthParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:    types.StringUnknown(),
+			params: stringvalidator.LengthParams{Max: 1},
+		},
+		"null": {
+			val:    types.StringNull(),
+			params: stringvalidator.LengthParams{Max: 1},
+		},
+		"runes": {
+			val:    types.StringValue("café"),
+			params: stringvalidator.LengthParams{Max: 4},
+		},
+		"runes-too-long": {
+			val:         types.StringValue(thumbsUp),
+			params:      stringvalidator.LengthParams{Max: 1},
+			expectError: true,
+		},
+		"bytes": {
+			val:    types.StringValue("cafe"),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Max: 4},
+		},
+		"bytes-too-long-accent": {
+			val:         types.StringValue("café"),
+			params:      stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Max: 4},
+			expectError: true,
+		},
+		"bytes-emoji": {
+			val:    types.StringValue(thumbsUp),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Min: 8, Max: 8},
+		},
+		"utf16-emoji": {
+			val:    types.StringValue(thumbsUp),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitUTF16, Min: 4, Max: 4},
+		},
+		"utf16-too-long": {
+			val:         types.StringValue(family),
+			params:      stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitUTF16, Max: 7},
+			expectError: true,
+		},
+		"graphemes-emoji": {
+			val:    types.StringValue(thumbsUp + family),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitGraphemes, Min: 2, Max: 2},
+		},
+		"graphemes-decomposed-accent": {
+			val:    types.StringValue("e\u0301"),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitGraphemes, Max: 1},
+		},
+		"graphemes-too-short": {
+			val:         types.StringValue(family),
+			params:      stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitGraphemes, Min: 2},
+			expectError: true,
+		},
+		"invalid-unit": {
+			val:         types.StringValue("value"),
+			params:      stringvalidator.LengthParams{Unit: "words", Max: 10},
+			expectError: true,
+		},
+		"invalid-bounds": {
+			val:         types.StringValue("value"),
+			params:      stringvalidator.LengthParams{Min: 10, Max: 5},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Length(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidTransformedLengthValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		params      stringvalidator.LengthParams
+		transforms  []stringvalidator.LengthTransform
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"trim": {
+			val:        types.StringValue("  value\n"),
+			params:     stringvalidator.LengthParams{Max: 5},
+			transforms: []stringvalidator.LengthTransform{stringvalidator.LengthTransformTrimSpace()},
+		},
+		"trim-too-long": {
+			val:         types.StringValue("  values\n"),
+			params:      stringvalidator.LengthParams{Max: 5},
+			transforms:  []stringvalidator.LengthTransform{stringvalidator.LengthTransformTrimSpace()},
+			expectError: true,
+		},
+		"base64": {
+			// 16 bytes.
+			val:        types.StringValue("AAECAwQFBgcICQoLDA0ODw=="),
+			params:     stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Min: 16, Max: 16},
+			transforms: []stringvalidator.LengthTransform{stringvalidator.LengthTransformDecode(formatstypes.EncodingBase64)},
+		},
+		"base64-too-short": {
+			// 8 bytes.
+			val:         types.StringValue("AAECAwQFBgc="),
+			params:      stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Min: 16},
+			transforms:  []stringvalidator.LengthTransform{stringvalidator.LengthTransformDecode(formatstypes.EncodingBase64)},
+			expectError: true,
+		},
+		"trim-then-hex": {
+			val:    types.StringValue(" 00ff10 "),
+			params: stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Max: 3},
+			transforms: []stringvalidator.LengthTransform{
+				stringvalidator.LengthTransformTrimSpace(),
+				stringvalidator.LengthTransformDecode(formatstypes.EncodingHex),
+			},
+		},
+		"invalid-base64": {
+			val:         types.StringValue("not base64!"),
+			params:      stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Max: 16},
+			transforms:  []stringvalidator.LengthTransform{stringvalidator.LengthTransformDecode(formatstypes.EncodingBase64)},
+			expectError: true,
+		},
+		"zero-transform": {
+			val:         types.StringValue("value"),
+			params:      stringvalidator.LengthParams{Max: 5},
+			transforms:  []stringvalidator.LengthTransform{stringvalidator.LengthTransformTrimSpace(), {}},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.TransformedLength(test.params, test.transforms...).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestLengthValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator           validator.String
+		description         string
+		markdownDescription string
+	}
+	tests := map[string]testCase{
+		"max-bytes": {
+			validator:           stringvalidator.Length(stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Max: 64}),
+			description:         "The value must be a string of at most 64 bytes",
+			markdownDescription: "The value must be a string of at most `64` bytes",
+		},
+		"min-max-graphemes": {
+			validator:           stringvalidator.Length(stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitGraphemes, Min: 1, Max: 10}),
+			description:         "The value must be a string of 1 to 10 grapheme clusters",
+			markdownDescription: "The value must be a string of `1` to `10` grapheme clusters",
+		},
+		"transformed": {
+			validator: stringvalidator.TransformedLength(
+				stringvalidator.LengthParams{Unit: stringvalidator.LengthUnitBytes, Min: 32},
+				stringvalidator.LengthTransformTrimSpace(),
+				stringvalidator.LengthTransformDecode(formatstypes.EncodingBase64),
+			),
+			description:         "The value must be a string of at least 32 bytes after removing the leading and trailing whitespaces and decoding the base64 value",
+			markdownDescription: "The value must be a string of at least `32` bytes after removing the leading and trailing whitespaces and decoding the `base64` value",
+		},
+		"zero-transform": {
+			validator:           stringvalidator.TransformedLength(stringvalidator.LengthParams{Max: 5}, stringvalidator.LengthTransform{}),
+			description:         "invalid configuration",
+			markdownDescription: "invalid configuration",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.validator.Description(context.Background()); got != test.description {
+				t.Errorf("expected description %q, got %q", test.description, got)
+			}
+			if got := test.validator.MarkdownDescription(context.Background()); got != test.markdownDescription {
+				t.Errorf("expected markdown description %q, got %q", test.markdownDescription, got)
+			}
+		})
+	}
+}
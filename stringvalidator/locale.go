@@ -0,0 +1,162 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/text/language"
+)
+
+var _ validator.String = locale{}
+
+// localeGrandfatheredTags is the list of the grandfathered tags of RFC 5646.
+var localeGrandfatheredTags = []string{
+	"art-lojban", "cel-gaulish", "en-GB-oed", "i-ami", "i-bnn", "i-default", "i-enochian", "i-hak",
+	"i-klingon", "i-lux", "i-mingo", "i-navajo", "i-pwn", "i-tao", "i-tay", "i-tsu", "no-bok", "no-nyn",
+	"sgn-BE-FR", "sgn-BE-NL", "sgn-CH-DE", "zh-guoyu", "zh-hakka", "zh-min", "zh-min-nan", "zh-xiang",
+}
+
+type LocaleParams struct {
+	// DenyLegacy rejects the grandfathered tags (Ex: i-klingon) and the deprecated subtags (Ex: iw for he).
+	DenyLegacy bool
+	// RequireRegion requires the region subtag (Ex: fr-FR instead of fr).
+	RequireRegion bool
+	// Regions is the list of allowed region subtags: ISO 3166-1 alpha-2 codes (Ex: FR) or UN M.49 codes (Ex: 419).
+	// If set, the region subtag is required.
+	Regions []string
+}
+
+func (p LocaleParams) description(format string) string {
+	description := fmt.Sprintf("a BCP 47 language tag (Ex: %s)", fmt.Sprintf(format, "fr-FR"))
+
+	var constraints []string
+	if p.DenyLegacy {
+		constraints = append(constraints, "without legacy tags")
+	}
+	switch {
+	case len(p.Regions) > 0:
+		regions := make([]string, 0, len(p.Regions))
+		for _, region := range p.Regions {
+			regions = append(regions, fmt.Sprintf(format, region))
+		}
+		constraints = append(constraints, fmt.Sprintf("with one of the regions %s", strings.Join(regions, ", ")))
+	case p.RequireRegion:
+		constraints = append(constraints, "with a region")
+	}
+
+	if len(constraints) > 0 {
+		description += ", " + strings.Join(constraints, ", ")
+	}
+
+	return description
+}
+
+func (p LocaleParams) validate(value string) error {
+	// The parser also accepts the underscore separator of the POSIX locales (Ex: en_US).
+	if strings.Contains(value, "_") {
+		return fmt.Errorf("%q is not a BCP 47 language tag, the subtags are separated by hyphens (Ex: en-US)", value)
+	}
+
+	tag, err := language.Raw.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid BCP 47 language tag: %w", value, err)
+	}
+
+	if _, confidence := tag.Base(); confidence != language.Exact {
+		return fmt.Errorf("%q does not have a language subtag", value)
+	}
+
+	if p.DenyLegacy {
+		for _, grandfathered := range localeGrandfatheredTags {
+			if strings.EqualFold(value, grandfathered) {
+				return fmt.Errorf("%q is a grandfathered tag, use %q instead", value, tag)
+			}
+		}
+
+		if canonical, err := language.CanonType(language.Deprecated | language.Legacy).Parse(value); err == nil && canonical != tag {
+			return fmt.Errorf("%q contains deprecated subtags, use %q instead", value, canonical)
+		}
+	}
+
+	region, confidence := tag.Region()
+	hasRegion := confidence == language.Exact
+	if hasRegion && !region.IsCountry() && !region.IsGroup() {
+		return fmt.Errorf("%q has an unknown region %q", value, region)
+	}
+
+	switch {
+	case len(p.Regions) > 0:
+		if hasRegion {
+			for _, r := range p.Regions {
+				if strings.EqualFold(r, region.String()) {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("%q does not have one of the regions %s", value, strings.Join(p.Regions, ", "))
+	case p.RequireRegion && !hasRegion:
+		return fmt.Errorf("%q does not have a region (Ex: %s-FR)", value, tag)
+	}
+
+	return nil
+}
+
+type locale struct {
+	params LocaleParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator locale) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator locale) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator locale) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validator.params.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid locale",
+			err.Error(),
+		)
+	}
+}
+
+// Locale validates that a string is a BCP 47 language tag (Ex: fr, fr-FR, zh-Hant-TW, es-419).
+//
+// The tags are parsed with the language data bundled in golang.org/x/text,
+// so the result is the same on any machine. The tags are case-insensitive.
+//
+// Parameters:
+//   - settings: LocaleParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid locale.
+func Locale(settings LocaleParams) validator.String {
+	return &locale{
+		params: settings,
+	}
+}
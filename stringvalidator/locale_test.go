@@ -0,0 +1,166 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidLocaleValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.LocaleParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-language": {
+			val: types.StringValue("fr"),
+		},
+		"valid-region": {
+			val: types.StringValue("fr-FR"),
+		},
+		"valid-case-insensitive": {
+			val: types.StringValue("EN-us"),
+		},
+		"valid-script": {
+			val: types.StringValue("zh-Hant-TW"),
+		},
+		"valid-region-group": {
+			val: types.StringValue("es-419"),
+		},
+		"valid-variant": {
+			val: types.StringValue("de-DE-1996"),
+		},
+		"valid-extension": {
+			val: types.StringValue("en-US-u-ca-gregory"),
+		},
+		"valid-grandfathered": {
+			val: types.StringValue("i-klingon"),
+		},
+		"valid-deprecated": {
+			val: types.StringValue("iw"),
+		},
+		"valid-require-region": {
+			val:   types.StringValue("pt-BR"),
+			param: stringvalidator.LocaleParams{RequireRegion: true},
+		},
+		"valid-regions": {
+			val:   types.StringValue("fr-BE"),
+			param: stringvalidator.LocaleParams{Regions: []string{"fr", "BE"}},
+		},
+		"valid-deny-legacy": {
+			val:   types.StringValue("he-IL"),
+			param: stringvalidator.LocaleParams{DenyLegacy: true},
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-underscore": {
+			val:         types.StringValue("en_US"),
+			expectError: true,
+		},
+		"invalid-syntax": {
+			val:         types.StringValue("en-"),
+			expectError: true,
+		},
+		"invalid-unknown-language": {
+			val:         types.StringValue("xx"),
+			expectError: true,
+		},
+		"invalid-undetermined": {
+			val:         types.StringValue("und"),
+			expectError: true,
+		},
+		"invalid-private-use": {
+			val:         types.StringValue("x-private"),
+			expectError: true,
+		},
+		"invalid-unknown-region": {
+			val:         types.StringValue("en-XY"),
+			expectError: true,
+		},
+		"invalid-grandfathered": {
+			val:         types.StringValue("i-klingon"),
+			param:       stringvalidator.LocaleParams{DenyLegacy: true},
+			expectError: true,
+		},
+		"invalid-deprecated": {
+			val:         types.StringValue("iw-IL"),
+			param:       stringvalidator.LocaleParams{DenyLegacy: true},
+			expectError: true,
+		},
+		"invalid-require-region": {
+			val:         types.StringValue("pt"),
+			param:       stringvalidator.LocaleParams{RequireRegion: true},
+			expectError: true,
+		},
+		"invalid-regions": {
+			val:         types.StringValue("fr-CA"),
+			param:       stringvalidator.LocaleParams{Regions: []string{"FR", "BE"}},
+			expectError: true,
+		},
+		"invalid-regions-missing": {
+			val:         types.StringValue("fr"),
+			param:       stringvalidator.LocaleParams{Regions: []string{"FR"}},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Locale(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidLocaleValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Locale(stringvalidator.LocaleParams{
+		DenyLegacy: true,
+		Regions:    []string{"FR", "BE"},
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a BCP 47 language tag (Ex: fr-FR), without legacy tags, with one of the regions FR, BE"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a BCP 47 language tag (Ex: `fr-FR`), without legacy tags, with one of the regions `FR`, `BE`"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,231 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = maintenanceWindow{}
+
+// maintenanceWindowDays is the list of the day names, starting on Monday.
+var maintenanceWindowDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+type MaintenanceWindowParams struct {
+	// AllowOvernight allows the windows ending the next day (Ex: 22:00-02:00).
+	AllowOvernight bool
+	// RequireTimezone requires the IANA timezone (Ex: Europe/Paris).
+	RequireTimezone bool
+	// MinDuration is the minimum length of the window. If 0, there is no lower bound.
+	MinDuration time.Duration
+	// MaxDuration is the maximum length of the window. If 0, there is no upper bound.
+	MaxDuration time.Duration
+}
+
+func (p MaintenanceWindowParams) description(format string) string {
+	description := fmt.Sprintf("a maintenance window %s (Ex: %s)",
+		fmt.Sprintf(format, "<days> <HH:MM>-<HH:MM> [timezone]"),
+		fmt.Sprintf(format, "sat,sun 22:00-02:00 Europe/Paris"),
+	)
+
+	var constraints []string
+	if p.RequireTimezone {
+		constraints = append(constraints, "with a timezone")
+	}
+	if !p.AllowOvernight {
+		constraints = append(constraints, "ending the same day")
+	}
+	switch {
+	case p.MinDuration > 0 && p.MaxDuration > 0:
+		constraints = append(constraints, fmt.Sprintf("lasting between %s and %s", internal.FormatDuration(p.MinDuration), internal.FormatDuration(p.MaxDuration)))
+	case p.MinDuration > 0:
+		constraints = append(constraints, fmt.Sprintf("lasting at least %s", internal.FormatDuration(p.MinDuration)))
+	case p.MaxDuration > 0:
+		constraints = append(constraints, fmt.Sprintf("lasting at most %s", internal.FormatDuration(p.MaxDuration)))
+	}
+
+	if len(constraints) > 0 {
+		description += ", " + strings.Join(constraints, ", ")
+	}
+
+	return description
+}
+
+// validate parses the window and returns the failing component and the error.
+func (p MaintenanceWindowParams) validate(value string) (component string, err error) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 || len(fields) > 3 {
+		return "", fmt.Errorf("expected <days> <HH:MM>-<HH:MM> [timezone], got %d components", len(fields))
+	}
+
+	if err := parseMaintenanceWindowDays(fields[0]); err != nil {
+		return "days", err
+	}
+
+	startValue, endValue, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return "time range", fmt.Errorf("%q is not a time range <HH:MM>-<HH:MM>", fields[1])
+	}
+
+	start, err := time.Parse("15:04", startValue)
+	if err != nil {
+		return "start time", fmt.Errorf("%q is not a time HH:MM between 00:00 and 23:59", startValue)
+	}
+
+	end, err := time.Parse("15:04", endValue)
+	if err != nil {
+		return "end time", fmt.Errorf("%q is not a time HH:MM between 00:00 and 23:59", endValue)
+	}
+
+	length := end.Sub(start)
+	switch {
+	case length == 0:
+		return "time range", fmt.Errorf("the start time and the end time of %q are the same", fields[1])
+	case length < 0 && !p.AllowOvernight:
+		return "time range", fmt.Errorf("the window %q ends the next day, which is not allowed", fields[1])
+	case length < 0:
+		length += 24 * time.Hour
+	}
+
+	if p.MinDuration > 0 && length < p.MinDuration {
+		return "time range", fmt.Errorf("the window %q lasts %s, expected at least %s", fields[1], internal.FormatDuration(length), internal.FormatDuration(p.MinDuration))
+	}
+
+	if p.MaxDuration > 0 && length > p.MaxDuration {
+		return "time range", fmt.Errorf("the window %q lasts %s, expected at most %s", fields[1], internal.FormatDuration(length), internal.FormatDuration(p.MaxDuration))
+	}
+
+	if len(fields) == 2 {
+		if p.RequireTimezone {
+			return "timezone", fmt.Errorf("the timezone is required (Ex: Europe/Paris)")
+		}
+		return "", nil
+	}
+
+	if _, _, err := internal.LoadTimezone(fields[2]); err != nil {
+		return "timezone", err
+	}
+
+	return "", nil
+}
+
+// parseMaintenanceWindowDays checks a comma separated list of days and ranges of days (Ex: mon-fri,sun).
+// The ranges may wrap around the end of the week (Ex: fri-mon).
+func parseMaintenanceWindowDays(value string) error {
+	seen := make(map[int]bool)
+
+	for _, item := range strings.Split(value, ",") {
+		firstValue, lastValue, isRange := strings.Cut(item, "-")
+
+		first, err := parseMaintenanceWindowDay(firstValue)
+		if err != nil {
+			return err
+		}
+
+		last := first
+		if isRange {
+			if last, err = parseMaintenanceWindowDay(lastValue); err != nil {
+				return err
+			}
+			if first == last {
+				return fmt.Errorf("the range %q starts and ends the same day", item)
+			}
+		}
+
+		for day := first; ; day = (day + 1) % len(maintenanceWindowDays) {
+			if seen[day] {
+				return fmt.Errorf("the day %s is listed more than once", maintenanceWindowDays[day])
+			}
+			seen[day] = true
+
+			if day == last {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseMaintenanceWindowDay(value string) (int, error) {
+	for i, day := range maintenanceWindowDays {
+		if strings.EqualFold(value, day) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%q is not a day (expected %s)", value, strings.Join(maintenanceWindowDays, ", "))
+}
+
+type maintenanceWindow struct {
+	params MaintenanceWindowParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator maintenanceWindow) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator maintenanceWindow) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator maintenanceWindow) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	component, err := validator.params.validate(request.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+
+	detail := fmt.Sprintf("The maintenance window %q is invalid: %s", request.ConfigValue.ValueString(), err)
+	if component != "" {
+		detail = fmt.Sprintf("The %s of the maintenance window %q is invalid: %s", component, request.ConfigValue.ValueString(), err)
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid maintenance window",
+		detail,
+	)
+}
+
+// MaintenanceWindow validates that a string is a weekly maintenance window:
+// a list of days, a time range and an optional IANA timezone (Ex: Mon 02:00-04:00, sat,sun 22:00-02:00 Europe/Paris).
+//
+// The days are the case-insensitive three-letter names, separated by commas, with ranges (Ex: mon-fri).
+// The timezone is checked against the embedded timezone database.
+// The length of the window ignores the daylight saving time changes.
+//
+// Parameters:
+//   - settings: MaintenanceWindowParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid maintenance window.
+func MaintenanceWindow(settings MaintenanceWindowParams) validator.String {
+	return &maintenanceWindow{
+		params: settings,
+	}
+}
@@ -0,0 +1,209 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidMaintenanceWindowValidator(t *testing.T) {
+	t.Parallel()
+
+	overnight := stringvalidator.MaintenanceWindowParams{AllowOvernight: true}
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.MaintenanceWindowParams
+		component   string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue("Mon 02:00-04:00"),
+		},
+		"valid-list": {
+			val: types.StringValue("sat,sun 02:00-04:00"),
+		},
+		"valid-range": {
+			val: types.StringValue("mon-fri 12:00-13:30"),
+		},
+		"valid-range-wrap": {
+			val: types.StringValue("FRI-MON 12:00-13:30"),
+		},
+		"valid-overnight": {
+			val:   types.StringValue("sat,sun 22:00-02:00 Europe/Paris"),
+			param: overnight,
+		},
+		"valid-timezone": {
+			val: types.StringValue("tue 00:00-23:59 America/New_York"),
+		},
+		"valid-timezone-utc": {
+			val: types.StringValue("tue 01:00-03:00 UTC"),
+			param: stringvalidator.MaintenanceWindowParams{
+				RequireTimezone: true,
+			},
+		},
+		"valid-duration": {
+			val: types.StringValue("sun 23:00-01:00"),
+			param: stringvalidator.MaintenanceWindowParams{
+				AllowOvernight: true,
+				MinDuration:    time.Hour,
+				MaxDuration:    2 * time.Hour,
+			},
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-missing-time": {
+			val:         types.StringValue("mon"),
+			expectError: true,
+		},
+		"invalid-too-many-components": {
+			val:         types.StringValue("mon 02:00-04:00 Europe/Paris extra"),
+			expectError: true,
+		},
+		"invalid-day": {
+			val:         types.StringValue("monday 02:00-04:00"),
+			component:   "days",
+			expectError: true,
+		},
+		"invalid-day-duplicate": {
+			val:         types.StringValue("mon-wed,tue 02:00-04:00"),
+			component:   "days",
+			expectError: true,
+		},
+		"invalid-day-range-same-day": {
+			val:         types.StringValue("mon-mon 02:00-04:00"),
+			component:   "days",
+			expectError: true,
+		},
+		"invalid-day-empty": {
+			val:         types.StringValue("mon, 02:00-04:00"),
+			component:   "days",
+			expectError: true,
+		},
+		"invalid-time-range": {
+			val:         types.StringValue("mon 02:00"),
+			component:   "time range",
+			expectError: true,
+		},
+		"invalid-start-time": {
+			val:         types.StringValue("mon 25:00-04:00"),
+			component:   "start time",
+			expectError: true,
+		},
+		"invalid-end-time": {
+			val:         types.StringValue("mon 02:00-04:60"),
+			component:   "end time",
+			expectError: true,
+		},
+		"invalid-same-time": {
+			val:         types.StringValue("mon 02:00-02:00"),
+			component:   "time range",
+			expectError: true,
+		},
+		"invalid-overnight": {
+			val:         types.StringValue("sat 22:00-02:00"),
+			component:   "time range",
+			expectError: true,
+		},
+		"invalid-too-short": {
+			val: types.StringValue("sat 23:30-00:15"),
+			param: stringvalidator.MaintenanceWindowParams{
+				AllowOvernight: true,
+				MinDuration:    time.Hour,
+			},
+			component:   "time range",
+			expectError: true,
+		},
+		"invalid-too-long": {
+			val: types.StringValue("sat 01:00-05:00"),
+			param: stringvalidator.MaintenanceWindowParams{
+				MaxDuration: 2 * time.Hour,
+			},
+			component:   "time range",
+			expectError: true,
+		},
+		"invalid-timezone": {
+			val:         types.StringValue("mon 02:00-04:00 Europe/Atlantis"),
+			component:   "timezone",
+			expectError: true,
+		},
+		"invalid-timezone-local": {
+			val:         types.StringValue("mon 02:00-04:00 Local"),
+			component:   "timezone",
+			expectError: true,
+		},
+		"invalid-timezone-required": {
+			val: types.StringValue("mon 02:00-04:00"),
+			param: stringvalidator.MaintenanceWindowParams{
+				RequireTimezone: true,
+			},
+			component:   "timezone",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.MaintenanceWindow(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.component != "" && !strings.HasPrefix(response.Diagnostics[0].Detail(), "The "+test.component+" of") {
+				t.Fatalf("expected the diagnostic to point at the %s, got %q", test.component, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestValidMaintenanceWindowValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.MaintenanceWindow(stringvalidator.MaintenanceWindowParams{
+		AllowOvernight: true,
+		MinDuration:    time.Hour,
+		MaxDuration:    4 * time.Hour,
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a maintenance window <days> <HH:MM>-<HH:MM> [timezone] (Ex: sat,sun 22:00-02:00 Europe/Paris), lasting between 1 hour and 4 hours"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a maintenance window `<days> <HH:MM>-<HH:MM> [timezone]` (Ex: `sat,sun 22:00-02:00 Europe/Paris`), lasting between 1 hour and 4 hours"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,326 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
+)
+
+var _ validator.String = namingTemplate{}
+
+var namingTemplatePlaceholderRegex = common.MustCompileRegex(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type NamingTemplateParams struct {
+	// Template is the naming convention (Ex: {env}-{app}-{index}).
+	// The placeholders are names between braces, the other characters are literals that must match exactly.
+	Template string
+	// Placeholders is the validator of each placeholder of the template.
+	Placeholders map[string]validator.String
+}
+
+// namingTemplatePart is a literal or a placeholder of a template.
+type namingTemplatePart struct {
+	literal     string
+	placeholder string
+}
+
+// parseNamingTemplate splits the template in literals and placeholders and checks the placeholders.
+func parseNamingTemplate(p NamingTemplateParams) ([]namingTemplatePart, error) {
+	if p.Template == "" {
+		return nil, fmt.Errorf("the template is empty")
+	}
+
+	var (
+		parts []namingTemplatePart
+		seen  = make(map[string]bool)
+	)
+
+	rest := p.Template
+	for rest != "" {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			parts = append(parts, namingTemplatePart{literal: rest})
+			break
+		}
+		if rest[start] == '}' {
+			return nil, fmt.Errorf("unexpected } in the template %q", p.Template)
+		}
+		if start > 0 {
+			parts = append(parts, namingTemplatePart{literal: rest[:start]})
+		}
+
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("unclosed { in the template %q", p.Template)
+		}
+
+		name := rest[start+1 : start+end]
+		switch {
+		case !namingTemplatePlaceholderRegex.MatchString(name):
+			return nil, fmt.Errorf("invalid placeholder name %q in the template %q", name, p.Template)
+		case seen[name]:
+			return nil, fmt.Errorf("the placeholder {%s} is used more than once in the template %q", name, p.Template)
+		case len(parts) > 0 && parts[len(parts)-1].placeholder != "":
+			return nil, fmt.Errorf("the placeholder {%s} follows another placeholder without a literal between them in the template %q", name, p.Template)
+		case p.Placeholders[name] == nil:
+			return nil, fmt.Errorf("the placeholder {%s} has no validator", name)
+		}
+		seen[name] = true
+
+		parts = append(parts, namingTemplatePart{placeholder: name})
+		rest = rest[start+end+1:]
+	}
+
+	for name := range p.Placeholders {
+		if !seen[name] {
+			return nil, fmt.Errorf("the placeholder {%s} is not in the template %q", name, p.Template)
+		}
+	}
+
+	return parts, nil
+}
+
+// namingTemplateSplit splits the value in non-empty placeholder values, the literals matching exactly.
+// Only the placeholder at index wide may contain the literals around it: the placeholders
+// before it end at the first occurrence of the next literal, the placeholders after it start
+// after the last occurrence of the previous literal. It returns false if the value does not match.
+func namingTemplateSplit(value string, parts []namingTemplatePart, wide int) ([]string, bool) {
+	// Leading and trailing literals.
+	if parts[0].placeholder == "" {
+		if !strings.HasPrefix(value, parts[0].literal) {
+			return nil, false
+		}
+		value, parts = value[len(parts[0].literal):], parts[1:]
+	}
+	if last := parts[len(parts)-1]; last.placeholder == "" {
+		if !strings.HasSuffix(value, last.literal) {
+			return nil, false
+		}
+		value, parts = value[:len(value)-len(last.literal)], parts[:len(parts)-1]
+	}
+
+	// The parts are now placeholder, literal, placeholder, ..., placeholder.
+	n := (len(parts) + 1) / 2
+	values := make([]string, n)
+
+	start := 0
+	for i := 0; i < wide; i++ {
+		literal := parts[2*i+1].literal
+		if start >= len(value) {
+			return nil, false
+		}
+		index := strings.Index(value[start+1:], literal)
+		if index < 0 {
+			return nil, false
+		}
+		values[i] = value[start : start+1+index]
+		start += 1 + index + len(literal)
+	}
+
+	end := len(value)
+	for i := n - 1; i > wide; i-- {
+		literal := parts[2*i-1].literal
+		if end-1 < start {
+			return nil, false
+		}
+		index := strings.LastIndex(value[start:end-1], literal)
+		if index < 0 {
+			return nil, false
+		}
+		values[i] = value[start+index+len(literal) : end]
+		end = start + index
+	}
+
+	if end <= start {
+		return nil, false
+	}
+	values[wide] = value[start:end]
+
+	return values, true
+}
+
+// validateNamingTemplatePlaceholder runs the validator of a placeholder on its value.
+// The other fields of the request (Ex: the configuration) are kept for the validators reading other attributes.
+func validateNamingTemplatePlaceholder(ctx context.Context, v validator.String, request validator.StringRequest, value string) diag.Diagnostics {
+	request.ConfigValue = types.StringValue(value)
+	response := new(validator.StringResponse)
+	v.ValidateString(ctx, request, response)
+
+	return response.Diagnostics
+}
+
+type namingTemplate struct {
+	params NamingTemplateParams
+	parts  []namingTemplatePart
+	// err is the error returned by the parsing of the template.
+	err error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator namingTemplate) Description(ctx context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	descriptions := make([]string, 0, len(validator.params.Placeholders))
+	for _, part := range validator.parts {
+		if part.placeholder != "" {
+			descriptions = append(descriptions, fmt.Sprintf("{%s}: %s", part.placeholder, validator.params.Placeholders[part.placeholder].Description(ctx)))
+		}
+	}
+
+	return fmt.Sprintf("The value must match the template %s where %s", validator.params.Template, strings.Join(descriptions, "; "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator namingTemplate) MarkdownDescription(ctx context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	description := fmt.Sprintf("The value must match the template `%s` where:\n", validator.params.Template)
+	for _, part := range validator.parts {
+		if part.placeholder != "" {
+			description += fmt.Sprintf("\n- `{%s}`: %s", part.placeholder, validator.params.Placeholders[part.placeholder].MarkdownDescription(ctx))
+		}
+	}
+
+	return description
+}
+
+// Validate performs the validation.
+func (validator namingTemplate) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	// The diagnostics of each placeholder value are computed once.
+	cache := make(map[string]diag.Diagnostics)
+	validate := func(name, placeholderValue string) diag.Diagnostics {
+		key := name + "\x00" + placeholderValue
+		if diags, ok := cache[key]; ok {
+			return diags
+		}
+
+		diags := validateNamingTemplatePlaceholder(ctx, validator.params.Placeholders[name], request, placeholderValue)
+		cache[key] = diags
+		return diags
+	}
+
+	names := make([]string, 0, len(validator.params.Placeholders))
+	for _, part := range validator.parts {
+		if part.placeholder != "" {
+			names = append(names, part.placeholder)
+		}
+	}
+
+	// Several splits are possible when a placeholder value may contain a literal (Ex: a kebab-case {app} in {env}-{app}-{index}).
+	// One split is tried per placeholder, where only this placeholder may contain the literals around it.
+	// The first split where all the placeholders are valid is kept, otherwise the split with the fewest invalid placeholders is reported.
+	var (
+		best       []string
+		bestErrors = -1
+	)
+	for wide := range names {
+		values, ok := namingTemplateSplit(value, validator.parts, wide)
+		if !ok {
+			continue
+		}
+
+		errors := 0
+		for i, name := range names {
+			if validate(name, values[i]).HasError() {
+				errors++
+			}
+		}
+
+		if bestErrors < 0 || errors < bestErrors {
+			best = values
+			bestErrors = errors
+		}
+		if errors == 0 {
+			break
+		}
+	}
+
+	// A template without placeholder is a literal.
+	if len(names) == 0 && value == validator.params.Template {
+		return
+	}
+
+	if bestErrors < 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid value",
+			fmt.Sprintf("The value %q does not match the template %q", value, validator.params.Template),
+		)
+		return
+	}
+
+	for i, name := range names {
+		for _, d := range validate(name, best[i]) {
+			summary := fmt.Sprintf("Invalid placeholder {%s}", name)
+			detail := fmt.Sprintf("The value %q of the placeholder {%s} in %q is invalid: %s: %s", best[i], name, value, d.Summary(), d.Detail())
+
+			if d.Severity() == diag.SeverityError {
+				response.Diagnostics.AddAttributeError(request.Path, summary, detail)
+			} else {
+				response.Diagnostics.AddAttributeWarning(request.Path, summary, detail)
+			}
+		}
+	}
+}
+
+// NamingTemplate validates that a string matches a naming convention template (Ex: {env}-{app}-{index}).
+//
+// Each placeholder of the template is checked by its own validator (Ex: OneOfWithDescription, Cases, Formats)
+// and the literals between the placeholders must match exactly. The placeholder values are never empty.
+// Two placeholders must be separated by a literal, and only one placeholder value may contain
+// the literals around it (Ex: a kebab-case {app} in {env}-{app}-{index}).
+// The template is parsed when the validator is created. If the template is invalid,
+// an error is returned when the validator is used.
+//
+// Parameters:
+//   - settings: NamingTemplateParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string matches the template.
+func NamingTemplate(settings NamingTemplateParams) validator.String {
+	parts, err := parseNamingTemplate(settings)
+
+	return &namingTemplate{
+		params: settings,
+		parts:  parts,
+		err:    err,
+	}
+}
@@ -0,0 +1,234 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	hstringvalidator "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func namingTemplatePlaceholders() map[string]validator.String {
+	return map[string]validator.String{
+		"env": stringvalidator.OneOfWithDescription(
+			stringvalidator.OneOfWithDescriptionValues{Value: "dev", Description: "development"},
+			stringvalidator.OneOfWithDescriptionValues{Value: "stg", Description: "staging"},
+			stringvalidator.OneOfWithDescriptionValues{Value: "prd", Description: "production"},
+		),
+		"app":   stringvalidator.Cases([]stringvalidator.CasesValidatorType{stringvalidator.CasesKebabCase}),
+		"index": hstringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]{2}$`), "must be a 2-digit number"),
+	}
+}
+
+func TestValidNamingTemplateValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val          types.String
+		template     string
+		placeholders map[string]validator.String
+		expectError  bool
+		wantSummary  string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:      types.StringUnknown(),
+			template: "{env}-{app}-{index}",
+		},
+		"null": {
+			val:      types.StringNull(),
+			template: "{env}-{app}-{index}",
+		},
+		"valid": {
+			val:      types.StringValue("prd-billing-01"),
+			template: "{env}-{app}-{index}",
+		},
+		"valid-placeholder-with-separator": {
+			val:      types.StringValue("dev-my-billing-app-42"),
+			template: "{env}-{app}-{index}",
+		},
+		"valid-literal-prefix": {
+			val:      types.StringValue("vm.dev.billing.01"),
+			template: "vm.{env}.{app}.{index}",
+		},
+		"invalid-env": {
+			val:         types.StringValue("qa-billing-01"),
+			template:    "{env}-{app}-{index}",
+			expectError: true,
+			wantSummary: "Invalid placeholder {env}",
+		},
+		"invalid-app": {
+			val:         types.StringValue("dev-Billing-01"),
+			template:    "{env}-{app}-{index}",
+			expectError: true,
+			wantSummary: "Invalid placeholder {app}",
+		},
+		"invalid-index": {
+			val:         types.StringValue("dev-billing-1"),
+			template:    "{env}-{app}-{index}",
+			expectError: true,
+			wantSummary: "Invalid placeholder {index}",
+		},
+		"invalid-separator": {
+			val:         types.StringValue("dev_billing_01"),
+			template:    "{env}-{app}-{index}",
+			expectError: true,
+			wantSummary: "Invalid value",
+		},
+		"invalid-literal-prefix": {
+			val:         types.StringValue("ct.dev.billing.01"),
+			template:    "vm.{env}.{app}.{index}",
+			expectError: true,
+			wantSummary: "Invalid value",
+		},
+		"invalid-empty-placeholder": {
+			val:         types.StringValue("dev--01"),
+			template:    "{env}-{app}-{index}",
+			expectError: true,
+		},
+		"invalid-configuration-missing-validator": {
+			val:         types.StringValue("dev-billing-01-x"),
+			template:    "{env}-{app}-{index}-{suffix}",
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"invalid-configuration-unused-validator": {
+			val:         types.StringValue("dev-billing"),
+			template:    "{env}-{app}",
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"invalid-configuration-unclosed-placeholder": {
+			val:         types.StringValue("dev-billing-01"),
+			template:    "{env}-{app}-{index",
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"invalid-configuration-duplicate-placeholder": {
+			val:      types.StringValue("dev-dev"),
+			template: "{env}-{env}",
+			placeholders: map[string]validator.String{
+				"env": stringvalidator.Cases([]stringvalidator.CasesValidatorType{stringvalidator.CasesKebabCase}),
+			},
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+		"invalid-configuration-adjacent-placeholders": {
+			val:         types.StringValue("devbilling"),
+			template:    "{env}{app}",
+			expectError: true,
+			wantSummary: "Invalid validator configuration",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			placeholders := test.placeholders
+			if placeholders == nil {
+				placeholders = namingTemplatePlaceholders()
+			}
+
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.NamingTemplate(stringvalidator.NamingTemplateParams{
+				Template:     test.template,
+				Placeholders: placeholders,
+			}).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantSummary != "" && response.Diagnostics[0].Summary() != test.wantSummary {
+				t.Fatalf("expected summary %q, got %q", test.wantSummary, response.Diagnostics[0].Summary())
+			}
+		})
+	}
+}
+
+func TestNamingTemplateValidatorLongValue(t *testing.T) {
+	t.Parallel()
+
+	placeholders := make(map[string]validator.String)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		placeholders[name] = hstringvalidator.RegexMatches(regexp.MustCompile(`^x$`), "must be x")
+	}
+	v := stringvalidator.NamingTemplate(stringvalidator.NamingTemplateParams{
+		Template:     "{a}-{b}-{c}-{d}-{e}-{f}",
+		Placeholders: placeholders,
+	})
+
+	// No split is valid, all the splits must be rejected quickly.
+	request := validator.StringRequest{
+		ConfigValue: types.StringValue(strings.Repeat("y-", 5000) + "y"),
+	}
+	response := validator.StringResponse{}
+
+	start := time.Now()
+	v.ValidateString(context.TODO(), request, &response)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the validation to finish quickly, took %s", elapsed)
+	}
+
+	if !response.Diagnostics.HasError() {
+		t.Fatal("expected error, got no error")
+	}
+}
+
+func TestNamingTemplateValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.NamingTemplate(stringvalidator.NamingTemplateParams{
+		Template: "{env}-{app}",
+		Placeholders: map[string]validator.String{
+			"env": stringvalidator.OneOfWithDescription(
+				stringvalidator.OneOfWithDescriptionValues{Value: "dev", Description: "development"},
+			),
+			"app": stringvalidator.Cases([]stringvalidator.CasesValidatorType{stringvalidator.CasesDisallowUpper}),
+		},
+	})
+	ctx := context.Background()
+
+	wantDescription := "The value must match the template {env}-{app} where {env}: " +
+		stringvalidator.OneOfWithDescription(stringvalidator.OneOfWithDescriptionValues{Value: "dev", Description: "development"}).Description(ctx) +
+		"; {app}: The value must respect the following rule : disallow uppercase characters"
+	if got := v.Description(ctx); got != wantDescription {
+		t.Errorf("expected description %q, got %q", wantDescription, got)
+	}
+
+	markdown := v.MarkdownDescription(ctx)
+	for _, want := range []string{
+		"The value must match the template `{env}-{app}` where:\n",
+		"\n- `{env}`: ",
+		"\n- `{app}`: The value must respect the following rule : disallow uppercase characters",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("expected markdown description containing %q, got %q", want, markdown)
+		}
+	}
+	if strings.Index(markdown, "`{env}`") > strings.Index(markdown, "`{app}`") {
+		t.Errorf("expected the placeholders in the order of the template, got %q", markdown)
+	}
+}
@@ -33,10 +33,10 @@ value is a valid MacAddress.
 Null (unconfigured) and unknown (known after apply) values are skipped.
 */
 func IsMacAddress() validator.String {
-	return &common.RegexValidator{
+	return common.MustNewRegexValidator(common.RegexValidator{
 		Desc:         "must be a valid mac address",
 		Regex:        `(?m)^([0-9A-Fa-f]{2}[:-]){5}([0-9A-Fa-f]{2})$`,
 		ErrorSummary: "Failed to parse mac address",
 		ErrorDetail:  "This value is not a valid mac address",
-	}
+	})
 }
@@ -33,10 +33,10 @@ value is a valid Netmask.
 Null (unconfigured) and unknown (known after apply) values are skipped.
 */
 func IsNetmask() validator.String {
-	return &common.RegexValidator{
+	return common.MustNewRegexValidator(common.RegexValidator{
 		Desc:         "must be a valid netmask",
 		Regex:        `(?m)^(((255\.){3}(255|254|252|248|240|224|192|128|0+))|((255\.){2}(255|254|252|248|240|224|192|128|0+)\.0)|((255\.)(255|254|252|248|240|224|192|128|0+)(\.0+){2})|((255|254|252|248|240|224|192|128|0+)(\.0+){3}))$`,
 		ErrorSummary: "Failed to parse netmask",
 		ErrorDetail:  "This value is not a valid netmask",
-	}
+	})
 }
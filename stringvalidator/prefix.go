@@ -19,6 +19,10 @@
 package stringvalidator
 
 import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
@@ -33,3 +37,92 @@ func PrefixContains(prefix string) validator.String {
 		Prefix: prefix,
 	}
 }
+
+type PrefixParams struct {
+	// Prefixes are the accepted prefixes: the value is valid if it matches any of them.
+	Prefixes []string
+	// PrefixPath is the path of a string attribute whose value is also accepted as a prefix
+	// (Ex: path.MatchRelative().AtParent().AtName("name") for the name of the parent).
+	// The attribute is skipped if it is null or unknown.
+	PrefixPath path.Expression
+	// Mode is where the prefix must be found in the value: common.PrefixModePrefix (default),
+	// common.PrefixModeSuffix or common.PrefixModeContains.
+	Mode common.PrefixMode
+	// CaseInsensitive compares the value and the prefixes regardless of the case.
+	CaseInsensitive bool
+}
+
+var _ validator.String = prefix{}
+
+type prefix struct {
+	common.PrefixValidator
+	// err is the error returned by the check of the configuration.
+	err error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator prefix) Description(ctx context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return validator.PrefixValidator.Description(ctx)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator prefix) MarkdownDescription(ctx context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return validator.PrefixValidator.MarkdownDescription(ctx)
+}
+
+// Validate performs the validation.
+func (validator prefix) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	validator.PrefixValidator.ValidateString(ctx, request, response)
+}
+
+// Prefix validates that a string starts with, ends with or contains one of the prefixes,
+// the literal ones or the value of another attribute.
+//
+// At least one of Prefixes or PrefixPath must be set, otherwise an error is returned when the validator is used.
+//
+// Parameters:
+//   - settings: PrefixParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string matches one of the prefixes.
+func Prefix(settings PrefixParams) validator.String {
+	var err error
+	if len(settings.Prefixes) == 0 && settings.PrefixPath.Equal(path.Expression{}) {
+		err = errors.New("at least one of Prefixes or PrefixPath must be set")
+	}
+
+	return &prefix{
+		PrefixValidator: common.PrefixValidator{
+			Prefixes:        settings.Prefixes,
+			PrefixPath:      settings.PrefixPath,
+			Mode:            settings.Mode,
+			CaseInsensitive: settings.CaseInsensitive,
+		},
+		err: err,
+	}
+}
@@ -22,10 +22,15 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator/common"
 )
 
 func TestPrefixContainsValidator(t *testing.T) {
@@ -75,3 +80,191 @@ func TestPrefixContainsValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestPrefixValidator(t *testing.T) {
+	t.Parallel()
+
+	config := func(parent tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name":  schema.StringAttribute{},
+					"child": schema.StringAttribute{},
+				},
+			},
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name":  tftypes.String,
+					"child": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name":  parent,
+				"child": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	type testCase struct {
+		val         types.String
+		params      stringvalidator.PrefixParams
+		parent      tftypes.Value
+		expectError bool
+		wantDetail  string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:    types.StringUnknown(),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"vm-"}},
+		},
+		"null": {
+			val:    types.StringNull(),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"vm-"}},
+		},
+		"any-of": {
+			val:    types.StringValue("ct-demo"),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"vm-", "ct-"}},
+		},
+		"any-of-invalid": {
+			val:         types.StringValue("demo-vm"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"vm-", "ct-"}},
+			expectError: true,
+			wantDetail:  `The value "demo-vm" does not start with any of the prefixes "vm-", "ct-".`,
+		},
+		"suffix": {
+			val:    types.StringValue("demo-vm"),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"-vm"}, Mode: common.PrefixModeSuffix},
+		},
+		"suffix-invalid": {
+			val:         types.StringValue("vm-demo"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"-vm"}, Mode: common.PrefixModeSuffix},
+			expectError: true,
+			wantDetail:  `The value "vm-demo" does not end with the suffix "-vm".`,
+		},
+		"contains": {
+			val:    types.StringValue("my-prod-vm"),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"-prod-"}, Mode: common.PrefixModeContains},
+		},
+		"contains-invalid": {
+			val:         types.StringValue("prod-vm"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"-prod-"}, Mode: common.PrefixModeContains},
+			expectError: true,
+		},
+		"case-insensitive": {
+			val:    types.StringValue("VM-demo"),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"vm-"}, CaseInsensitive: true},
+		},
+		"case-sensitive-invalid": {
+			val:         types.StringValue("VM-demo"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"vm-"}},
+			expectError: true,
+		},
+		"invalid-mode": {
+			val:         types.StringValue("vm-demo"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"vm-"}, Mode: "regex"},
+			expectError: true,
+		},
+		"invalid-configuration-no-prefix": {
+			val:         types.StringValue("vm-demo"),
+			params:      stringvalidator.PrefixParams{},
+			expectError: true,
+			wantDetail:  "at least one of Prefixes or PrefixPath must be set",
+		},
+		"path": {
+			val:    types.StringValue("parent-child"),
+			params: stringvalidator.PrefixParams{PrefixPath: path.MatchRoot("name")},
+			parent: tftypes.NewValue(tftypes.String, "parent-"),
+		},
+		"path-invalid": {
+			val:         types.StringValue("other-child"),
+			params:      stringvalidator.PrefixParams{PrefixPath: path.MatchRoot("name")},
+			parent:      tftypes.NewValue(tftypes.String, "parent-"),
+			expectError: true,
+			wantDetail:  `The value "other-child" does not start with the prefix "parent-".`,
+		},
+		"path-or-literal": {
+			val:    types.StringValue("shared-child"),
+			params: stringvalidator.PrefixParams{Prefixes: []string{"shared-"}, PrefixPath: path.MatchRoot("name")},
+			parent: tftypes.NewValue(tftypes.String, "parent-"),
+		},
+		"path-unknown": {
+			val:    types.StringValue("other-child"),
+			params: stringvalidator.PrefixParams{PrefixPath: path.MatchRoot("name")},
+			parent: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"path-null-with-literal-invalid": {
+			val:         types.StringValue("other-child"),
+			params:      stringvalidator.PrefixParams{Prefixes: []string{"shared-"}, PrefixPath: path.MatchRoot("name")},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			parent := test.parent
+			if parent.Type() == nil {
+				parent = tftypes.NewValue(tftypes.String, nil)
+			}
+
+			request := validator.StringRequest{
+				Path:           path.Root("child"),
+				PathExpression: path.MatchRoot("child"),
+				ConfigValue:    test.val,
+				Config:         config(parent),
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Prefix(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantDetail != "" && response.Diagnostics[0].Detail() != test.wantDetail {
+				t.Fatalf("expected detail %q, got %q", test.wantDetail, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestPrefixValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		validator           validator.String
+		description         string
+		markdownDescription string
+	}
+	tests := map[string]testCase{
+		"prefix-contains": {
+			validator:           stringvalidator.PrefixContains("urn:test:demo:"),
+			description:         `must start with "urn:test:demo:"`,
+			markdownDescription: "This value must start with `urn:test:demo:`.",
+		},
+		"suffix-case-insensitive": {
+			validator:           stringvalidator.Prefix(stringvalidator.PrefixParams{Prefixes: []string{".com", ".org"}, Mode: common.PrefixModeSuffix, CaseInsensitive: true}),
+			description:         `must end with one of ".com" or ".org" (case-insensitive)`,
+			markdownDescription: "This value must end with one of `.com` or `.org` (case-insensitive).",
+		},
+		"path": {
+			validator:           stringvalidator.Prefix(stringvalidator.PrefixParams{Prefixes: []string{"shared-"}, PrefixPath: path.MatchRoot("name")}),
+			description:         `must start with one of "shared-" or the value of the name attribute`,
+			markdownDescription: "This value must start with one of `shared-` or the value of the [`name`](#name) attribute.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.validator.Description(context.Background()); got != test.description {
+				t.Errorf("expected description %q, got %q", test.description, got)
+			}
+			if got := test.validator.MarkdownDescription(context.Background()); got != test.markdownDescription {
+				t.Errorf("expected markdown description %q, got %q", test.markdownDescription, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = privateKey{}
+
+type PrivateKeyParams struct {
+	// AllowEncrypted allows the encrypted private keys (PKCS#8 ENCRYPTED PRIVATE KEY or legacy OpenSSL encryption).
+	// The public key of an encrypted private key cannot be compared to the certificate.
+	AllowEncrypted bool
+	// CertificatePath is the path of the attribute holding the PEM encoded certificate of the key.
+	// If set, the public key of the first certificate must match the private key.
+	// The check is skipped if the certificate is null, unknown or cannot be parsed.
+	CertificatePath path.Expression
+}
+
+type privateKey struct {
+	params PrivateKeyParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator privateKey) Description(_ context.Context) string {
+	description := "The value must be a PEM encoded private key (PKCS#1, PKCS#8 or EC)"
+	if !validator.params.AllowEncrypted {
+		description += ", not encrypted"
+	}
+
+	if validator.hasCertificatePath() {
+		description += fmt.Sprintf(", matching the certificate of the %s attribute", validator.params.CertificatePath)
+	}
+
+	return description
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator privateKey) MarkdownDescription(_ context.Context) string {
+	description := "The value must be a PEM encoded private key (PKCS#1, PKCS#8 or EC)"
+	if !validator.params.AllowEncrypted {
+		description += ", not encrypted"
+	}
+
+	if validator.hasCertificatePath() {
+		description += fmt.Sprintf(", matching the certificate of the [`%s`](#%s) attribute", validator.params.CertificatePath, validator.params.CertificatePath)
+	}
+
+	return description
+}
+
+func (validator privateKey) hasCertificatePath() bool {
+	return !validator.params.CertificatePath.Equal(path.Expression{})
+}
+
+// Validate performs the validation.
+// The diagnostics never contain the value to avoid leaking the key material.
+func (validator privateKey) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	key, err := internal.ParsePEMPrivateKey(request.ConfigValue.ValueString())
+	switch {
+	case errors.Is(err, internal.ErrEncryptedPrivateKey):
+		if !validator.params.AllowEncrypted {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid private key",
+				"Encrypted private keys are not allowed",
+			)
+		}
+		return
+	case err != nil:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Failed to parse private key",
+			err.Error(),
+		)
+		return
+	}
+
+	if !validator.hasCertificatePath() {
+		return
+	}
+
+	paths, diags := request.Config.PathMatches(ctx, request.PathExpression.Merge(validator.params.CertificatePath))
+	response.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, p := range paths {
+		var certificateValue attr.Value
+		diags = request.Config.GetAttribute(ctx, p, &certificateValue)
+		if diags.HasError() {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+				fmt.Sprintf("Unable to retrieve attribute path: %q", p),
+			)
+			return
+		}
+
+		// The certificate is checked when it is known.
+		if certificateValue.IsNull() || certificateValue.IsUnknown() {
+			continue
+		}
+
+		certificateString, ok := certificateValue.(types.String)
+		if !ok {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+				fmt.Sprintf("The attribute %s is not a string", p),
+			)
+			return
+		}
+
+		// A malformed certificate is reported by the validators of its own attribute.
+		certificates, err := internal.ParsePEMCertificates(certificateString.ValueString())
+		if err != nil {
+			continue
+		}
+
+		publicKey, ok := certificates[0].PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+		if !ok || !publicKey.Equal(key.Public()) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Private key does not match the certificate",
+				fmt.Sprintf("The public key of the private key does not match the public key of the certificate of the %s attribute", p),
+			)
+		}
+	}
+}
+
+// PrivateKey validates that a string holds a PEM encoded private key (PKCS#1, PKCS#8 or EC).
+//
+// If a certificate path is given, the private key must match the public key of the certificate.
+// The diagnostics never contain the key material.
+//
+// Parameters:
+//   - settings: PrivateKeyParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string holds a valid private key.
+func PrivateKey(settings PrivateKeyParams) validator.String {
+	return &privateKey{
+		params: settings,
+	}
+}
@@ -0,0 +1,327 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var _ validator.String = reservedWords{}
+
+type ReservedWordsComparison string
+
+const (
+	// ReservedWordsComparisonExact compares the values as they are.
+	ReservedWordsComparisonExact ReservedWordsComparison = ""
+	// ReservedWordsComparisonCaseInsensitive compares the lowercase values (Ex: Admin matches admin).
+	ReservedWordsComparisonCaseInsensitive ReservedWordsComparison = "case_insensitive"
+	// ReservedWordsComparisonFold compares the values after the NFKC normalization and the Unicode case folding
+	// (Ex: ＡＤＭＩＮ matches admin, STRASSE matches straße).
+	ReservedWordsComparisonFold ReservedWordsComparison = "fold"
+)
+
+// ReservedWord is a forbidden value with the reason shown in the diagnostic.
+type ReservedWord struct {
+	Value string
+	// Reason is optional (Ex: reserved for the administrator account).
+	Reason string
+}
+
+type ReservedWordsParams struct {
+	// Words is the list of the reserved values. The whole value must match.
+	Words []ReservedWord
+	// Prefixes is the list of the forbidden prefixes.
+	Prefixes []ReservedWord
+	// Suffixes is the list of the forbidden suffixes.
+	Suffixes []ReservedWord
+	// Substrings is the list of the forbidden substrings.
+	Substrings []ReservedWord
+	// Comparison is the way the values are compared. Default is ReservedWordsComparisonExact.
+	Comparison ReservedWordsComparison
+}
+
+// normalize transforms a value according to the comparison.
+func (p ReservedWordsParams) normalize(value string) string {
+	switch p.Comparison {
+	case ReservedWordsComparisonCaseInsensitive:
+		return strings.ToLower(value)
+	case ReservedWordsComparisonFold:
+		return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(value)))
+	default:
+		return value
+	}
+}
+
+func (p ReservedWordsParams) validateConfig() error {
+	switch p.Comparison {
+	case ReservedWordsComparisonExact, ReservedWordsComparisonCaseInsensitive, ReservedWordsComparisonFold:
+	default:
+		return fmt.Errorf("unknown comparison %q", p.Comparison)
+	}
+
+	for _, list := range [][]ReservedWord{p.Words, p.Prefixes, p.Suffixes, p.Substrings} {
+		for _, word := range list {
+			if word.Value == "" {
+				return fmt.Errorf("the reserved words must not be empty")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p ReservedWordsParams) description(format string) string {
+	list := func(words []ReservedWord) string {
+		values := make([]string, 0, len(words))
+		for _, word := range words {
+			values = append(values, fmt.Sprintf(format, word.Value))
+		}
+		return strings.Join(values, ", ")
+	}
+
+	var constraints []string
+	if len(p.Words) > 0 {
+		constraints = append(constraints, "not one of "+list(p.Words))
+	}
+	if len(p.Prefixes) > 0 {
+		constraints = append(constraints, "not starting with "+list(p.Prefixes))
+	}
+	if len(p.Suffixes) > 0 {
+		constraints = append(constraints, "not ending with "+list(p.Suffixes))
+	}
+	if len(p.Substrings) > 0 {
+		constraints = append(constraints, "not containing "+list(p.Substrings))
+	}
+
+	description := "reserved"
+	if len(constraints) > 0 {
+		description += ": " + strings.Join(constraints, ", ")
+	}
+
+	switch p.Comparison {
+	case ReservedWordsComparisonCaseInsensitive:
+		description += " (case-insensitive)"
+	case ReservedWordsComparisonFold:
+		description += " (case-insensitive, Unicode folded)"
+	}
+
+	return description
+}
+
+// parseReservedWords reads a list of reserved words.
+// Each line is a kind (word, prefix, suffix or substring), a value and an optional reason, separated by spaces.
+// A line with a single value is a word. The empty lines and the lines starting with # are ignored.
+func parseReservedWords(r io.Reader) (ReservedWordsParams, error) {
+	var p ReservedWordsParams
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) == 1 {
+			p.Words = append(p.Words, ReservedWord{Value: fields[0]})
+			continue
+		}
+
+		word := ReservedWord{
+			Value:  fields[1],
+			Reason: strings.Join(fields[2:], " "),
+		}
+
+		switch fields[0] {
+		case "word":
+			p.Words = append(p.Words, word)
+		case "prefix":
+			p.Prefixes = append(p.Prefixes, word)
+		case "suffix":
+			p.Suffixes = append(p.Suffixes, word)
+		case "substring":
+			p.Substrings = append(p.Substrings, word)
+		default:
+			return p, fmt.Errorf("line %d: unknown kind %q (expected word, prefix, suffix or substring)", line, fields[0])
+		}
+	}
+
+	return p, scanner.Err()
+}
+
+type reservedWords struct {
+	params ReservedWordsParams
+	// words holds the reserved words by normalized value.
+	words map[string]ReservedWord
+	// affixes holds the forbidden prefixes, suffixes and substrings with their normalized values.
+	affixes []reservedAffixes
+	// err is the error returned by the loading of the list or the check of the configuration.
+	err error
+}
+
+type reservedAffixes struct {
+	words      []ReservedWord
+	normalized []string
+	match      func(s, word string) bool
+	message    string
+}
+
+// newReservedWords checks the configuration and normalizes the reserved values once.
+func newReservedWords(settings ReservedWordsParams) *reservedWords {
+	v := &reservedWords{
+		params: settings,
+	}
+
+	if v.err = settings.validateConfig(); v.err != nil {
+		return v
+	}
+
+	v.words = make(map[string]ReservedWord, len(settings.Words))
+	for _, word := range settings.Words {
+		// The first occurrence of a word is kept for its reason.
+		if normalized := settings.normalize(word.Value); v.words[normalized].Value == "" {
+			v.words[normalized] = word
+		}
+	}
+
+	for _, affixes := range []reservedAffixes{
+		{words: settings.Prefixes, match: strings.HasPrefix, message: "starts with the forbidden prefix"},
+		{words: settings.Suffixes, match: strings.HasSuffix, message: "ends with the forbidden suffix"},
+		{words: settings.Substrings, match: strings.Contains, message: "contains the forbidden substring"},
+	} {
+		for _, word := range affixes.words {
+			affixes.normalized = append(affixes.normalized, settings.normalize(word.Value))
+		}
+		v.affixes = append(v.affixes, affixes)
+	}
+
+	return v
+}
+
+// validate returns an error describing the first reserved word found in the value.
+func (validator reservedWords) validate(value string) error {
+	normalized := validator.params.normalize(value)
+
+	reservedError := func(message string, word ReservedWord) error {
+		err := fmt.Errorf("the value %q %s %q", value, message, word.Value)
+		if word.Reason != "" {
+			err = fmt.Errorf("%w: %s", err, word.Reason)
+		}
+		return err
+	}
+
+	if word, ok := validator.words[normalized]; ok {
+		return reservedError("is the reserved word", word)
+	}
+
+	for _, affixes := range validator.affixes {
+		for i, word := range affixes.words {
+			if affixes.match(normalized, affixes.normalized[i]) {
+				return reservedError(affixes.message, word)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Description describes the validation in plain text formatting.
+func (validator reservedWords) Description(_ context.Context) string {
+	return "The value must not be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator reservedWords) MarkdownDescription(_ context.Context) string {
+	return "The value must not be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator reservedWords) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	if err := validator.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Reserved value",
+			err.Error(),
+		)
+	}
+}
+
+// ReservedWords validates that a string is not a reserved word
+// and does not start with, end with or contain a forbidden value.
+//
+// Parameters:
+//   - settings: ReservedWordsParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is not reserved.
+func ReservedWords(settings ReservedWordsParams) validator.String {
+	return newReservedWords(settings)
+}
+
+// ReservedWordsFromFS validates that a string is not reserved, with the lists read from the file name of fsys (Ex: an embed.FS).
+//
+// Each line of the file is a kind (word, prefix, suffix or substring), a value and an optional reason, separated by spaces
+// (Ex: prefix vcd- reserved for the platform). A line with a single value is a word.
+// The empty lines and the lines starting with # are ignored.
+// The lists of the file are added to the lists of settings.
+// The file is read once, if it cannot be read, an error is returned when the validator is used.
+//
+// Parameters:
+//   - fsys: The file system containing the file.
+//   - name: The name of the file in fsys.
+//   - settings: ReservedWordsParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is not reserved.
+func ReservedWordsFromFS(fsys fs.FS, name string, settings ReservedWordsParams) validator.String {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return &reservedWords{params: settings, err: fmt.Errorf("failed to open the reserved words file %s: %w", name, err)}
+	}
+	defer f.Close()
+
+	loaded, err := parseReservedWords(f)
+	if err != nil {
+		return &reservedWords{params: settings, err: fmt.Errorf("failed to read the reserved words file %s: %w", name, err)}
+	}
+
+	settings.Words = append(append([]ReservedWord{}, settings.Words...), loaded.Words...)
+	settings.Prefixes = append(append([]ReservedWord{}, settings.Prefixes...), loaded.Prefixes...)
+	settings.Suffixes = append(append([]ReservedWord{}, settings.Suffixes...), loaded.Suffixes...)
+	settings.Substrings = append(append([]ReservedWord{}, settings.Substrings...), loaded.Substrings...)
+
+	return newReservedWords(settings)
+}
@@ -0,0 +1,254 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"embed"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+//go:embed testdata/reserved_words*.txt
+var reservedWordsFS embed.FS
+
+func TestValidReservedWordsValidator(t *testing.T) {
+	t.Parallel()
+
+	settings := stringvalidator.ReservedWordsParams{
+		Words: []stringvalidator.ReservedWord{
+			{Value: "admin", Reason: "reserved for the administrator account"},
+			{Value: "root"},
+		},
+		Prefixes:   []stringvalidator.ReservedWord{{Value: "vcd-", Reason: "reserved for the platform"}},
+		Suffixes:   []stringvalidator.ReservedWord{{Value: "-system"}},
+		Substrings: []stringvalidator.ReservedWord{{Value: "default"}},
+	}
+	withComparison := func(comparison stringvalidator.ReservedWordsComparison) stringvalidator.ReservedWordsParams {
+		s := settings
+		s.Comparison = comparison
+		return s
+	}
+
+	type testCase struct {
+		val         types.String
+		params      stringvalidator.ReservedWordsParams
+		expectError bool
+		wantDetail  string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:    types.StringUnknown(),
+			params: settings,
+		},
+		"null": {
+			val:    types.StringNull(),
+			params: settings,
+		},
+		"valid": {
+			val:    types.StringValue("my-app"),
+			params: settings,
+		},
+		"valid-word-in-value": {
+			val:    types.StringValue("administrator"),
+			params: settings,
+		},
+		"invalid-word": {
+			val:         types.StringValue("admin"),
+			params:      settings,
+			expectError: true,
+			wantDetail:  `the value "admin" is the reserved word "admin": reserved for the administrator account`,
+		},
+		"invalid-word-without-reason": {
+			val:         types.StringValue("root"),
+			params:      settings,
+			expectError: true,
+			wantDetail:  `the value "root" is the reserved word "root"`,
+		},
+		"invalid-prefix": {
+			val:         types.StringValue("vcd-app"),
+			params:      settings,
+			expectError: true,
+			wantDetail:  `the value "vcd-app" starts with the forbidden prefix "vcd-": reserved for the platform`,
+		},
+		"invalid-suffix": {
+			val:         types.StringValue("app-system"),
+			params:      settings,
+			expectError: true,
+		},
+		"invalid-substring": {
+			val:         types.StringValue("my-default-app"),
+			params:      settings,
+			expectError: true,
+		},
+		"exact-case": {
+			val:    types.StringValue("Admin"),
+			params: settings,
+		},
+		"case-insensitive-word": {
+			val:         types.StringValue("Admin"),
+			params:      withComparison(stringvalidator.ReservedWordsComparisonCaseInsensitive),
+			expectError: true,
+		},
+		"case-insensitive-prefix": {
+			val:         types.StringValue("VCD-app"),
+			params:      withComparison(stringvalidator.ReservedWordsComparisonCaseInsensitive),
+			expectError: true,
+		},
+		"case-insensitive-fullwidth": {
+			val:    types.StringValue("ＡＤＭＩＮ"),
+			params: withComparison(stringvalidator.ReservedWordsComparisonCaseInsensitive),
+		},
+		"fold-fullwidth": {
+			val:         types.StringValue("ＡＤＭＩＮ"),
+			params:      withComparison(stringvalidator.ReservedWordsComparisonFold),
+			expectError: true,
+		},
+		"fold-sharp-s": {
+			val: types.StringValue("STRASSE"),
+			params: stringvalidator.ReservedWordsParams{
+				Words:      []stringvalidator.ReservedWord{{Value: "straße"}},
+				Comparison: stringvalidator.ReservedWordsComparisonFold,
+			},
+			expectError: true,
+		},
+		"invalid-comparison": {
+			val:         types.StringValue("my-app"),
+			params:      withComparison("unknown"),
+			expectError: true,
+		},
+		"invalid-empty-word": {
+			val: types.StringValue("my-app"),
+			params: stringvalidator.ReservedWordsParams{
+				Words: []stringvalidator.ReservedWord{{Value: ""}},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.ReservedWords(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantDetail != "" && response.Diagnostics[0].Detail() != test.wantDetail {
+				t.Fatalf("expected detail %q, got %q", test.wantDetail, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestValidReservedWordsFromFSValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		name        string
+		expectError bool
+		wantDetail  string
+	}
+	tests := map[string]testCase{
+		"valid": {
+			val:  types.StringValue("my-app"),
+			name: "testdata/reserved_words.txt",
+		},
+		"invalid-word": {
+			val:         types.StringValue("admin"),
+			name:        "testdata/reserved_words.txt",
+			expectError: true,
+		},
+		"invalid-word-with-reason": {
+			val:         types.StringValue("root"),
+			name:        "testdata/reserved_words.txt",
+			expectError: true,
+			wantDetail:  `the value "root" is the reserved word "root": reserved for the system account`,
+		},
+		"invalid-settings-word": {
+			val:         types.StringValue("guest"),
+			name:        "testdata/reserved_words.txt",
+			expectError: true,
+		},
+		"invalid-prefix": {
+			val:         types.StringValue("vcd-app"),
+			name:        "testdata/reserved_words.txt",
+			expectError: true,
+		},
+		"invalid-file": {
+			val:         types.StringValue("my-app"),
+			name:        "testdata/reserved_words_invalid.txt",
+			expectError: true,
+			wantDetail:  `line 2: unknown kind "keyword"`,
+		},
+		"missing-file": {
+			val:         types.StringValue("my-app"),
+			name:        "testdata/missing.txt",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.ReservedWordsFromFS(reservedWordsFS, test.name, stringvalidator.ReservedWordsParams{
+				Words: []stringvalidator.ReservedWord{{Value: "guest"}},
+			}).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantDetail != "" && !strings.Contains(response.Diagnostics[0].Detail(), test.wantDetail) {
+				t.Fatalf("expected detail containing %q, got %q", test.wantDetail, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestReservedWordsValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.ReservedWords(stringvalidator.ReservedWordsParams{
+		Words:      []stringvalidator.ReservedWord{{Value: "admin"}, {Value: "root"}},
+		Prefixes:   []stringvalidator.ReservedWord{{Value: "vcd-"}},
+		Comparison: stringvalidator.ReservedWordsComparisonCaseInsensitive,
+	})
+
+	if got, want := v.Description(context.Background()), "The value must not be reserved: not one of admin, root, not starting with vcd- (case-insensitive)"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(context.Background()), "The value must not be reserved: not one of `admin`, `root`, not starting with `vcd-` (case-insensitive)"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
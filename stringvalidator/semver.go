@@ -0,0 +1,272 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var (
+	_ validator.String = semver{}
+	_ validator.String = semverConstraint{}
+)
+
+// SemverPrefix is the policy applied to the v prefix of a version (Ex: v1.2.3).
+type SemverPrefix string
+
+const (
+	// SemverPrefixForbidden rejects the v prefix (strict mode). This is the default.
+	SemverPrefixForbidden SemverPrefix = ""
+	// SemverPrefixOptional accepts the version with or without the v prefix (lenient mode).
+	SemverPrefixOptional SemverPrefix = "optional"
+	// SemverPrefixRequired requires the v prefix.
+	SemverPrefixRequired SemverPrefix = "required"
+)
+
+type SemverParams struct {
+	// Prefix is the policy applied to the v prefix.
+	Prefix SemverPrefix
+	// AllowPreRelease allows the pre-release versions (Ex: 1.2.3-rc.1).
+	AllowPreRelease bool
+	// AllowBuildMetadata allows the build metadata (Ex: 1.2.3+build.5).
+	AllowBuildMetadata bool
+	// MinVersion is the lowest allowed version (inclusive). If empty, there is no lower bound.
+	MinVersion string
+	// MaxVersion is the version above the allowed window (exclusive). If empty, there is no upper bound.
+	MaxVersion string
+}
+
+func (p SemverParams) description(format string) string {
+	description := "a semantic version (MAJOR.MINOR.PATCH)"
+
+	switch p.Prefix {
+	case SemverPrefixForbidden:
+		description += fmt.Sprintf(" without %s prefix", fmt.Sprintf(format, "v"))
+	case SemverPrefixOptional:
+		description += fmt.Sprintf(" with an optional %s prefix", fmt.Sprintf(format, "v"))
+	case SemverPrefixRequired:
+		description += fmt.Sprintf(" with the %s prefix", fmt.Sprintf(format, "v"))
+	}
+
+	if !p.AllowPreRelease {
+		description += ", without pre-release"
+	}
+	if !p.AllowBuildMetadata {
+		description += ", without build metadata"
+	}
+
+	var window []string
+	if p.MinVersion != "" {
+		window = append(window, fmt.Sprintf("greater than or equal to %s", fmt.Sprintf(format, p.MinVersion)))
+	}
+	if p.MaxVersion != "" {
+		window = append(window, fmt.Sprintf("lower than %s", fmt.Sprintf(format, p.MaxVersion)))
+	}
+	if len(window) > 0 {
+		description += ", " + strings.Join(window, " and ")
+	}
+
+	return description
+}
+
+type semver struct {
+	params     SemverParams
+	minVersion *internal.Semver
+	maxVersion *internal.Semver
+	err        error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator semver) Description(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator semver) MarkdownDescription(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator semver) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	hasPrefix := strings.HasPrefix(value, "v")
+	switch {
+	case hasPrefix && validator.params.Prefix == SemverPrefixForbidden:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid semantic version",
+			fmt.Sprintf("The version %q must not start with the v prefix", value),
+		)
+		return
+	case !hasPrefix && validator.params.Prefix == SemverPrefixRequired:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid semantic version",
+			fmt.Sprintf("The version %q must start with the v prefix", value),
+		)
+		return
+	}
+
+	v, err := internal.ParseSemver(strings.TrimPrefix(value, "v"))
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid semantic version",
+			err.Error(),
+		)
+		return
+	}
+
+	if v.PreRelease != "" && !validator.params.AllowPreRelease {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid semantic version",
+			fmt.Sprintf("The pre-release versions are not allowed, got %q", value),
+		)
+	}
+
+	if v.Build != "" && !validator.params.AllowBuildMetadata {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid semantic version",
+			fmt.Sprintf("The build metadata is not allowed, got %q", value),
+		)
+	}
+
+	if validator.minVersion != nil && v.Compare(*validator.minVersion) < 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Version out of range",
+			fmt.Sprintf("The version %q must be greater than or equal to %s", value, validator.params.MinVersion),
+		)
+	}
+
+	if validator.maxVersion != nil && v.Compare(*validator.maxVersion) >= 0 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Version out of range",
+			fmt.Sprintf("The version %q must be lower than %s", value, validator.params.MaxVersion),
+		)
+	}
+}
+
+// Semver validates that a string is a semantic version as defined by https://semver.org (Ex: 1.2.3, v1.2.3-rc.1).
+//
+// The v prefix is rejected unless Prefix allows it. The pre-release and the build metadata
+// are rejected unless AllowPreRelease and AllowBuildMetadata are set.
+// The version must be in the window [MinVersion, MaxVersion[ following the semver precedence rules.
+// The bounds are parsed once, a malformed bound is reported as an invalid configuration.
+//
+// Parameters:
+//   - settings: SemverParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid semantic version.
+func Semver(settings SemverParams) validator.String {
+	v := &semver{
+		params: settings,
+	}
+
+	v.minVersion, v.err = parseSemverBound(settings.MinVersion)
+	if v.err == nil {
+		v.maxVersion, v.err = parseSemverBound(settings.MaxVersion)
+	}
+
+	return v
+}
+
+// parseSemverBound parses a bound of the version window. An empty bound returns nil.
+func parseSemverBound(bound string) (*internal.Semver, error) {
+	if bound == "" {
+		return nil, nil
+	}
+
+	v, err := internal.ParseSemver(strings.TrimPrefix(bound, "v"))
+	if err != nil {
+		return nil, fmt.Errorf("the version window is invalid: %w", err)
+	}
+
+	return &v, nil
+}
+
+type semverConstraint struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator semverConstraint) Description(_ context.Context) string {
+	return "The value must be a satisfiable version constraint (Ex: >= 1.2, < 2.0). Supported operators are =, !=, >, >=, <, <=, ~>, ~ and ^, the groups are separated by ||"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator semverConstraint) MarkdownDescription(_ context.Context) string {
+	return "The value must be a satisfiable version constraint (Ex: `>= 1.2, < 2.0`). Supported operators are `=`, `!=`, `>`, `>=`, `<`, `<=`, `~>`, `~` and `^`, the groups are separated by `||`"
+}
+
+// Validate performs the validation.
+func (validator semverConstraint) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := internal.ParseSemverConstraints(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid version constraint",
+			err.Error(),
+		)
+	}
+}
+
+// SemverConstraint validates that a string is a version constraint expression (Ex: >= 1.2, < 2.0 || ~> 3.1).
+//
+// The constraints of a group are separated by commas or spaces and must all be satisfied,
+// the groups are separated by || and at least one of them must be satisfied.
+// The expression is rejected if no version can satisfy it (Ex: > 2.0, < 1.0).
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid version constraint.
+func SemverConstraint() validator.String {
+	return &semverConstraint{}
+}
@@ -0,0 +1,342 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidSemverValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.SemverParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue("1.2.3"),
+		},
+		"valid-zero": {
+			val: types.StringValue("0.0.0"),
+		},
+		"valid-prefix-optional": {
+			val: types.StringValue("v1.2.3"),
+			param: stringvalidator.SemverParams{
+				Prefix: stringvalidator.SemverPrefixOptional,
+			},
+		},
+		"valid-no-prefix-optional": {
+			val: types.StringValue("1.2.3"),
+			param: stringvalidator.SemverParams{
+				Prefix: stringvalidator.SemverPrefixOptional,
+			},
+		},
+		"valid-prefix-required": {
+			val: types.StringValue("v1.30.2"),
+			param: stringvalidator.SemverParams{
+				Prefix: stringvalidator.SemverPrefixRequired,
+			},
+		},
+		"valid-pre-release": {
+			val: types.StringValue("1.2.3-rc.1"),
+			param: stringvalidator.SemverParams{
+				AllowPreRelease: true,
+			},
+		},
+		"valid-build-metadata": {
+			val: types.StringValue("1.2.3+build.5"),
+			param: stringvalidator.SemverParams{
+				AllowBuildMetadata: true,
+			},
+		},
+		"valid-window": {
+			val: types.StringValue("1.29.0"),
+			param: stringvalidator.SemverParams{
+				MinVersion: "1.28.0",
+				MaxVersion: "1.31.0",
+			},
+		},
+		"valid-window-min-inclusive": {
+			val: types.StringValue("1.28.0"),
+			param: stringvalidator.SemverParams{
+				MinVersion: "1.28.0",
+			},
+		},
+		"invalid-window-pre-release-before-min": {
+			val: types.StringValue("1.28.0-rc.1"),
+			param: stringvalidator.SemverParams{
+				AllowPreRelease: true,
+				MinVersion:      "1.28.0",
+			},
+			expectError: true,
+		},
+		"invalid-window-max-exclusive": {
+			val: types.StringValue("1.31.0"),
+			param: stringvalidator.SemverParams{
+				MaxVersion: "1.31.0",
+			},
+			expectError: true,
+		},
+		"invalid-window-below-min": {
+			val: types.StringValue("1.9.0"),
+			param: stringvalidator.SemverParams{
+				MinVersion: "1.10.0",
+			},
+			expectError: true,
+		},
+		"invalid-window-configuration": {
+			val: types.StringValue("1.2.3"),
+			param: stringvalidator.SemverParams{
+				MinVersion: "1.2",
+			},
+			expectError: true,
+		},
+		"invalid-window-configuration-max": {
+			// The malformed bound is reported even if the value is invalid.
+			val: types.StringValue("not a version"),
+			param: stringvalidator.SemverParams{
+				MaxVersion: "2.x",
+			},
+			expectError: true,
+		},
+		"invalid-prefix-forbidden": {
+			val:         types.StringValue("v1.2.3"),
+			expectError: true,
+		},
+		"invalid-prefix-required": {
+			val: types.StringValue("1.2.3"),
+			param: stringvalidator.SemverParams{
+				Prefix: stringvalidator.SemverPrefixRequired,
+			},
+			expectError: true,
+		},
+		"invalid-pre-release": {
+			val:         types.StringValue("1.2.3-beta"),
+			expectError: true,
+		},
+		"invalid-build-metadata": {
+			val:         types.StringValue("1.2.3+20260101"),
+			expectError: true,
+		},
+		"invalid-partial": {
+			val:         types.StringValue("1.2"),
+			expectError: true,
+		},
+		"invalid-leading-zero": {
+			val:         types.StringValue("1.02.3"),
+			expectError: true,
+		},
+		"invalid-pre-release-leading-zero": {
+			val: types.StringValue("1.2.3-rc.01"),
+			param: stringvalidator.SemverParams{
+				AllowPreRelease: true,
+			},
+			expectError: true,
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-uppercase-prefix": {
+			val: types.StringValue("V1.2.3"),
+			param: stringvalidator.SemverParams{
+				Prefix: stringvalidator.SemverPrefixOptional,
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Semver(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidSemverValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Semver(stringvalidator.SemverParams{
+		Prefix:          stringvalidator.SemverPrefixOptional,
+		AllowPreRelease: true,
+		MinVersion:      "1.28.0",
+		MaxVersion:      "2.0.0",
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a semantic version (MAJOR.MINOR.PATCH) with an optional v prefix, without build metadata, greater than or equal to 1.28.0 and lower than 2.0.0"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a semantic version (MAJOR.MINOR.PATCH) with an optional `v` prefix, without build metadata, greater than or equal to `1.28.0` and lower than `2.0.0`"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+
+	invalid := stringvalidator.Semver(stringvalidator.SemverParams{
+		MinVersion: "1.2",
+	})
+	if got, want := invalid.Description(ctx), "invalid configuration"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+}
+
+func TestValidSemverConstraintValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-exact": {
+			val: types.StringValue("1.2.3"),
+		},
+		"valid-range": {
+			val: types.StringValue(">= 1.2, < 2.0"),
+		},
+		"valid-range-without-spaces": {
+			val: types.StringValue(">=1.2.0,<2.0.0"),
+		},
+		"valid-range-space-separated": {
+			val: types.StringValue(">=1.2.0 <2.0.0"),
+		},
+		"valid-pessimistic": {
+			val: types.StringValue("~> 1.2"),
+		},
+		"valid-tilde": {
+			val: types.StringValue("~1.2.3"),
+		},
+		"valid-caret": {
+			val: types.StringValue("^0.2.3"),
+		},
+		"valid-prefix": {
+			val: types.StringValue(">= v1.2.0"),
+		},
+		"valid-pre-release": {
+			val: types.StringValue(">= 1.2.0-rc.1, < 1.2.0"),
+		},
+		"valid-or": {
+			val: types.StringValue("> 2.0, < 1.0 || ~> 3.1"),
+		},
+		"valid-not-equal": {
+			val: types.StringValue(">= 1.2.0, != 1.2.1, < 1.3.0"),
+		},
+		"valid-single-version-range": {
+			val: types.StringValue(">= 1.2.0, <= 1.2.0"),
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-operator": {
+			val:         types.StringValue("=> 1.2"),
+			expectError: true,
+		},
+		"invalid-unknown-operator": {
+			val:         types.StringValue("== 1.2"),
+			expectError: true,
+		},
+		"invalid-version": {
+			val:         types.StringValue(">= 1.2.x"),
+			expectError: true,
+		},
+		"invalid-partial-pre-release": {
+			val:         types.StringValue(">= 1.2-rc.1"),
+			expectError: true,
+		},
+		"invalid-trailing-comma": {
+			val:         types.StringValue(">= 1.2,"),
+			expectError: true,
+		},
+		"invalid-empty-group": {
+			val:         types.StringValue(">= 1.2 ||"),
+			expectError: true,
+		},
+		"invalid-missing-version": {
+			val:         types.StringValue(">="),
+			expectError: true,
+		},
+		"unsatisfiable-range": {
+			val:         types.StringValue("> 2.0, < 1.0"),
+			expectError: true,
+		},
+		"unsatisfiable-exclusive-bounds": {
+			val:         types.StringValue("> 1.2.0, < 1.2.0"),
+			expectError: true,
+		},
+		"unsatisfiable-excluded": {
+			val:         types.StringValue("= 1.2.3, != 1.2.3"),
+			expectError: true,
+		},
+		"unsatisfiable-pessimistic": {
+			val:         types.StringValue("~> 1.2.0, >= 1.3.0"),
+			expectError: true,
+		},
+		"unsatisfiable-caret": {
+			val:         types.StringValue("^0.0.3, >= 0.0.4"),
+			expectError: true,
+		},
+		"unsatisfiable-all-groups": {
+			val:         types.StringValue("> 2.0, < 1.0 || 1.0.0, 2.0.0"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.SemverConstraint().ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = sshPublicKey{}
+
+// SSHKeyType is a family of SSH keys.
+type SSHKeyType string
+
+const (
+	// SSHKeyTypeED25519 is the ssh-ed25519 key type.
+	SSHKeyTypeED25519 SSHKeyType = internal.SSHKeyTypeED25519
+	// SSHKeyTypeECDSA is the ecdsa-sha2-nistp256, ecdsa-sha2-nistp384 and ecdsa-sha2-nistp521 key types.
+	SSHKeyTypeECDSA SSHKeyType = internal.SSHKeyTypeECDSA
+	// SSHKeyTypeRSA is the ssh-rsa key type.
+	SSHKeyTypeRSA SSHKeyType = internal.SSHKeyTypeRSA
+
+	// SSHDefaultMinRSAKeySize is the default minimum modulus size in bits of the RSA keys.
+	SSHDefaultMinRSAKeySize = internal.SSHDefaultMinRSAKeySize
+)
+
+type SSHPublicKeyParams struct {
+	// KeyTypes is the list of allowed key types.
+	// If empty, SSHKeyTypeED25519, SSHKeyTypeECDSA and SSHKeyTypeRSA are allowed.
+	KeyTypes []SSHKeyType
+	// MinRSAKeySize is the minimum modulus size in bits of the RSA keys.
+	// If 0, SSHDefaultMinRSAKeySize is used.
+	MinRSAKeySize int
+}
+
+func (p SSHPublicKeyParams) keyTypes() []SSHKeyType {
+	if len(p.KeyTypes) == 0 {
+		return []SSHKeyType{SSHKeyTypeED25519, SSHKeyTypeECDSA, SSHKeyTypeRSA}
+	}
+
+	return p.KeyTypes
+}
+
+func (p SSHPublicKeyParams) minRSAKeySize() int {
+	if p.MinRSAKeySize <= 0 {
+		return SSHDefaultMinRSAKeySize
+	}
+
+	return p.MinRSAKeySize
+}
+
+func (p SSHPublicKeyParams) description(format string) string {
+	types := make([]string, 0, len(p.keyTypes()))
+	for _, t := range p.keyTypes() {
+		types = append(types, fmt.Sprintf(format, t))
+	}
+
+	description := fmt.Sprintf("a SSH public key in the authorized_keys format of type %s", strings.Join(types, ", "))
+	for _, t := range p.keyTypes() {
+		if t == SSHKeyTypeRSA {
+			description += fmt.Sprintf(" (RSA keys of at least %d bits)", p.minRSAKeySize())
+			break
+		}
+	}
+
+	return description
+}
+
+type sshPublicKey struct {
+	params SSHPublicKeyParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator sshPublicKey) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator sshPublicKey) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator sshPublicKey) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := internal.CheckSSHAuthorizedKey(request.ConfigValue.ValueString(), validator.params.KeyTypes, validator.params.MinRSAKeySize); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid SSH public key",
+			err.Error(),
+		)
+	}
+}
+
+// SSHPublicKey validates that a string is a SSH public key in the authorized_keys format
+// ([options] keytype base64-key [comment]).
+//
+// The key must decode, its embedded type must match the declared type and be allowed.
+// The RSA keys must have a modulus of at least MinRSAKeySize bits.
+//
+// Parameters:
+//   - settings: SSHPublicKeyParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid SSH public key.
+func SSHPublicKey(settings SSHPublicKeyParams) validator.String {
+	return &sshPublicKey{
+		params: settings,
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+// Keys generated with ssh-keygen.
+const (
+	testSSHKeyED25519  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOYSqgYMK4Xy5WA1EFQg+JOEYkrJgafCHK8/3cipLH43"
+	testSSHKeyECDSA384 = "ecdsa-sha2-nistp384 AAAAE2VjZHNhLXNoYTItbmlzdHAzODQAAAAIbmlzdHAzODQAAABhBHuic9LJ6EaR59rE5OX+z5qOWVDLEfJn6aBvVaQbkGH3Is91T7EjYQNrEn/HPrkwLWMqVQSikEYFlV5tLFLqUBwbjzD/KgYpso8nyS+9QSMijMs8ISZwtGbedosPeR90BA=="
+	testSSHKeyRSA2048  = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDEiudT+FemFGf5f+ZZ7M7OQYo6lF+dvr1fvrlIddsFBoNv9R47iaJOoqki7uVtQFiqctMSsMIm6Ag+SvA4OQLv9u1dHg3c9QwCUMKnTBrbX/IVj4iPKqaAN/zBX5CeUR5jap9T38FR0anuOazq2WF6Gxf1X/oDSBLMiVgj7jOtZ7foJRUX1w5htHUHllgudnnIXWtDuJqXHzJv127bRcfei/zIb4bIm9g0tQNbOwMjzMN2x5TyOfyIlqWxYaWbcO5dWKeiu9JnDcz3W67XKARQ5TMo8/5ogkKJZb1X/BIrLPxiC3dVTNbCfa6wwxilg49J8ciZI9slWTCgiibTC06r"
+	testSSHKeyRSA1024  = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQDiJUhdorRIaptXGdgwrdxSLiztp1Sv9UxMVXNjWGbw53xyJpXnieXHt57YKfnTWsa29Jw2uNOrnYYdE1MVMDD7D4yhqCXB4Fre+nU1HMMRVj6HKmSaPgTjMdv0cKEKMDTsI/gI6j3HpDw4TlDRm9pp6BGseySUj2qoYY4YYlPDBw=="
+	testSSHKeyDSA      = "ssh-dss AAAAB3NzaC1kc3MAAACBAPGIfvVuNKMjl1b6IodQrrxGCdx8WCOmLBHBHSdK4vdq26CS9P+4EjXVRVXp7svR9wZ3orZYyPhB46VydcChiSegVxlyfZwh/HYzDImqwtDYwiBEk1NXQ8UdoJN7LnM8dCXrvYRtF7INcnJgpxJdfGk0DHfOeKlabu/5WNdmI/mDAAAAFQDqAfFGrqK8mzDWs5gz8qUToYB70wAAAIEArYC9uReFAPcpEjHTzjq633ZQxYiC/fgo8pa7eU1AmbRciGAeZmuBxRHdjeEx9qrmQKSoXCAntC03pwZU3ABbnifs0axbHG0E+ui6DDAJ4bSigzJH6wNbBb+Gzq8OZu6+5HplDTaBgdaQhbL37/YbWH4N1/UyAdZuPNbUvEx5jVcAAACBAPGAZyAXnFnIyQ00DVAhTDIm5i8q8RjiXBQqfXTsKOmJ43InNsjr0/69YeXjnWhknOB1N2tt/82ai/5HVCc2uDMmJtuCJnq/32rNvhu/sydchKBPeHSDnpdF95xnYlRGCL8ggCCea7FM/xeKUCzjiWhP5RQUGPn0xOS5ybVJbugZ"
+)
+
+func TestValidSSHPublicKeyValidator(t *testing.T) {
+	t.Parallel()
+
+	ed25519Blob := strings.Fields(testSSHKeyED25519)[1]
+	rsaBlob := strings.Fields(testSSHKeyRSA2048)[1]
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.SSHPublicKeyParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-ed25519": {
+			val: types.StringValue(testSSHKeyED25519),
+		},
+		"valid-ecdsa": {
+			val: types.StringValue(testSSHKeyECDSA384),
+		},
+		"valid-rsa": {
+			val: types.StringValue(testSSHKeyRSA2048),
+		},
+		"valid-comment": {
+			val: types.StringValue(testSSHKeyED25519 + " John Doe <john@example.com>"),
+		},
+		"valid-options": {
+			val: types.StringValue(`from="10.0.0.0/8",command="echo \"hello world\"",no-pty ` + testSSHKeyED25519 + " user@example.com"),
+		},
+		"valid-trailing-newline": {
+			val: types.StringValue(testSSHKeyED25519 + "\n"),
+		},
+		"valid-rsa-1024-allowed": {
+			val: types.StringValue(testSSHKeyRSA1024),
+			param: stringvalidator.SSHPublicKeyParams{
+				MinRSAKeySize: 1024,
+			},
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-missing-blob": {
+			val:         types.StringValue("ssh-ed25519"),
+			expectError: true,
+		},
+		"invalid-base64": {
+			val:         types.StringValue("ssh-ed25519 AAAA!!!!"),
+			expectError: true,
+		},
+		"invalid-truncated-blob": {
+			val:         types.StringValue("ssh-ed25519 " + ed25519Blob[:40]),
+			expectError: true,
+		},
+		"invalid-type-mismatch": {
+			val:         types.StringValue("ssh-ed25519 " + rsaBlob),
+			expectError: true,
+		},
+		"invalid-unsupported-type": {
+			val:         types.StringValue(testSSHKeyDSA),
+			expectError: true,
+		},
+		"invalid-type-not-allowed": {
+			val: types.StringValue(testSSHKeyRSA2048),
+			param: stringvalidator.SSHPublicKeyParams{
+				KeyTypes: []stringvalidator.SSHKeyType{stringvalidator.SSHKeyTypeED25519, stringvalidator.SSHKeyTypeECDSA},
+			},
+			expectError: true,
+		},
+		"invalid-rsa-too-small": {
+			val:         types.StringValue(testSSHKeyRSA1024),
+			expectError: true,
+		},
+		"invalid-unterminated-option": {
+			val:         types.StringValue(`command="echo ` + testSSHKeyED25519),
+			expectError: true,
+		},
+		"invalid-multiple-lines": {
+			val:         types.StringValue(testSSHKeyED25519 + "\n" + testSSHKeyRSA2048),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.SSHPublicKey(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidSSHPublicKeyValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.SSHPublicKey(stringvalidator.SSHPublicKeyParams{})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a SSH public key in the authorized_keys format of type ed25519, ecdsa, rsa (RSA keys of at least 2048 bits)"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a SSH public key in the authorized_keys format of type `ed25519`, `ecdsa`, `rsa` (RSA keys of at least 2048 bits)"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,265 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = timestamp{}
+
+type TimestampParams struct {
+	// Layouts is the list of accepted layouts (See time.Parse). If empty, time.RFC3339 is used.
+	// Each layout must contain a numeric timezone offset (Ex: Z07:00, -0700).
+	Layouts []string
+	// Clock returns the current time used by Future and MaxAhead.
+	// If nil, time.Now is used.
+	Clock func() time.Time
+	// Future requires the timestamp to be in the future.
+	Future bool
+	// MaxAhead is the maximum duration between now and the timestamp (Ex: 30 * 24 * time.Hour).
+	// It must not be negative.
+	// If 0, there is no limit.
+	MaxAhead time.Duration
+	// AfterPath is the path of the attribute holding a timestamp that must be before this one.
+	// The check is skipped if the other timestamp is null, unknown or cannot be parsed.
+	AfterPath path.Expression
+}
+
+func (p TimestampParams) layouts() []string {
+	if len(p.Layouts) == 0 {
+		return []string{time.RFC3339}
+	}
+
+	return p.Layouts
+}
+
+func (p TimestampParams) now() time.Time {
+	if p.Clock == nil {
+		return time.Now()
+	}
+
+	return p.Clock()
+}
+
+func (p TimestampParams) hasAfterPath() bool {
+	return !p.AfterPath.Equal(path.Expression{})
+}
+
+// validateConfig ensures that all the layouts hold a numeric timezone offset and that MaxAhead is not negative.
+// The timezone abbreviations (MST) are not accepted because they are ambiguous.
+func (p TimestampParams) validateConfig() error {
+	for _, layout := range p.layouts() {
+		if !strings.Contains(layout, "Z07") && !strings.Contains(layout, "-07") {
+			return fmt.Errorf("the layout %q does not contain a numeric timezone offset", layout)
+		}
+	}
+
+	if p.MaxAhead < 0 {
+		return fmt.Errorf("invalid MaxAhead %s: the duration must not be negative", p.MaxAhead)
+	}
+
+	return nil
+}
+
+func (p TimestampParams) parse(value string) (time.Time, error) {
+	for _, layout := range p.layouts() {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	if len(p.Layouts) == 0 {
+		return time.Time{}, fmt.Errorf("%q is not a valid RFC 3339 timestamp with a timezone offset (Ex: 2026-01-02T15:04:05Z)", value)
+	}
+
+	return time.Time{}, fmt.Errorf("%q does not match any of the layouts %s", value, strings.Join(p.layouts(), ", "))
+}
+
+func (p TimestampParams) description(format string, attribute func(path.Expression) string) string {
+	layouts := make([]string, 0, len(p.layouts()))
+	for _, layout := range p.layouts() {
+		layouts = append(layouts, fmt.Sprintf(format, layout))
+	}
+
+	description := "a timestamp with a timezone offset"
+	if len(p.Layouts) == 0 {
+		description = fmt.Sprintf("a RFC 3339 timestamp with a timezone offset (Ex: %s)", fmt.Sprintf(format, "2026-01-02T15:04:05Z"))
+	} else {
+		description += fmt.Sprintf(" matching the layouts %s", strings.Join(layouts, ", "))
+	}
+
+	if p.Future {
+		description += ", in the future"
+	}
+	if p.MaxAhead > 0 {
+		description += fmt.Sprintf(", no further than %s ahead", internal.FormatDuration(p.MaxAhead))
+	}
+	if p.hasAfterPath() {
+		description += fmt.Sprintf(", after the timestamp of the %s attribute", attribute(p.AfterPath))
+	}
+
+	return description
+}
+
+type timestamp struct {
+	params TimestampParams
+	err    error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator timestamp) Description(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.params.description("%s", func(p path.Expression) string {
+		return p.String()
+	})
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator timestamp) MarkdownDescription(_ context.Context) string {
+	if validator.err != nil {
+		return "invalid configuration"
+	}
+
+	return "The value must be " + validator.params.description("`%s`", func(p path.Expression) string {
+		return fmt.Sprintf("[`%s`](#%s)", p, p)
+	})
+}
+
+// Validate performs the validation.
+func (validator timestamp) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	t, err := validator.params.parse(value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timestamp",
+			err.Error(),
+		)
+		return
+	}
+
+	now := validator.params.now()
+
+	if validator.params.Future && !t.After(now) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("The timestamp %q must be in the future", value),
+		)
+	}
+
+	if validator.params.MaxAhead > 0 && t.After(now.Add(validator.params.MaxAhead)) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("The timestamp %q must be no further than %s ahead (%s)", value, internal.FormatDuration(validator.params.MaxAhead), now.Add(validator.params.MaxAhead).Format(time.RFC3339)),
+		)
+	}
+
+	if !validator.params.hasAfterPath() {
+		return
+	}
+
+	paths, diags := request.Config.PathMatches(ctx, request.PathExpression.Merge(validator.params.AfterPath))
+	response.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, p := range paths {
+		var otherValue attr.Value
+		diags = request.Config.GetAttribute(ctx, p, &otherValue)
+		if diags.HasError() {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+				fmt.Sprintf("Unable to retrieve attribute path: %q", p),
+			)
+			return
+		}
+
+		// The other timestamp is checked when it is known.
+		if otherValue.IsNull() || otherValue.IsUnknown() {
+			continue
+		}
+
+		otherString, ok := otherValue.(types.String)
+		if !ok {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("Invalid configuration for attribute %s", request.Path),
+				fmt.Sprintf("The attribute %s is not a string", p),
+			)
+			return
+		}
+
+		// A malformed timestamp is reported by the validators of its own attribute.
+		other, err := validator.params.parse(otherString.ValueString())
+		if err != nil {
+			continue
+		}
+
+		if !t.After(other) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid timestamp",
+				fmt.Sprintf("The timestamp %q must be after the timestamp %q of the %s attribute", value, otherString.ValueString(), p),
+			)
+		}
+	}
+}
+
+// Timestamp validates that a string is a RFC 3339 timestamp (Ex: 2026-01-02T15:04:05Z)
+// or matches one of the given layouts. The timezone offset is always required.
+//
+// The timestamp may be required to be in the future, no further than a duration ahead
+// and after the timestamp of another attribute (Ex: end_time after start_time).
+//
+// Parameters:
+//   - settings: TimestampParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid timestamp.
+func Timestamp(settings TimestampParams) validator.String {
+	return &timestamp{
+		params: settings,
+		err:    settings.validateConfig(),
+	}
+}
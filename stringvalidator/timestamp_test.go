@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidTimestampValidator(t *testing.T) {
+	t.Parallel()
+
+	clock := func() time.Time {
+		return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	config := func(startTime tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"start_time": schema.StringAttribute{},
+					"end_time":   schema.StringAttribute{},
+				},
+			},
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"start_time": tftypes.String,
+					"end_time":   tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"start_time": startTime,
+				"end_time":   tftypes.NewValue(tftypes.String, nil),
+			}),
+		}
+	}
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.TimestampParams
+		startTime   tftypes.Value
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid-utc": {
+			val: types.StringValue("2026-01-02T15:04:05Z"),
+		},
+		"valid-offset": {
+			val: types.StringValue("2026-01-02T15:04:05+02:00"),
+		},
+		"valid-fraction": {
+			val: types.StringValue("2026-01-02T15:04:05.123Z"),
+		},
+		"valid-layout": {
+			val: types.StringValue("2026-01-02 15:04 +0100"),
+			param: stringvalidator.TimestampParams{
+				Layouts: []string{time.RFC3339, "2006-01-02 15:04 -0700"},
+			},
+		},
+		"valid-future": {
+			val: types.StringValue("2026-03-01T12:00:01Z"),
+			param: stringvalidator.TimestampParams{
+				Clock:  clock,
+				Future: true,
+			},
+		},
+		"valid-future-offset": {
+			// 13:30+01:00 is 12:30 UTC.
+			val: types.StringValue("2026-03-01T13:30:00+01:00"),
+			param: stringvalidator.TimestampParams{
+				Clock:  clock,
+				Future: true,
+			},
+		},
+		"valid-max-ahead": {
+			val: types.StringValue("2026-03-31T12:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				Clock:    clock,
+				MaxAhead: 30 * 24 * time.Hour,
+			},
+		},
+		"valid-after": {
+			val: types.StringValue("2026-03-02T00:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				AfterPath: path.MatchRoot("start_time"),
+			},
+			startTime: tftypes.NewValue(tftypes.String, "2026-03-01T23:00:00Z"),
+		},
+		"valid-after-unknown": {
+			val: types.StringValue("2026-03-02T00:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				AfterPath: path.MatchRoot("start_time"),
+			},
+			startTime: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"valid-after-malformed": {
+			val: types.StringValue("2026-03-02T00:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				AfterPath: path.MatchRoot("start_time"),
+			},
+			startTime: tftypes.NewValue(tftypes.String, "tomorrow"),
+		},
+		"invalid-missing-offset": {
+			val:         types.StringValue("2026-01-02T15:04:05"),
+			expectError: true,
+		},
+		"invalid-date-only": {
+			val:         types.StringValue("2026-01-02"),
+			expectError: true,
+		},
+		"invalid-date": {
+			val:         types.StringValue("2026-02-30T15:04:05Z"),
+			expectError: true,
+		},
+		"invalid-layout": {
+			val: types.StringValue("2026-01-02T15:04:05Z"),
+			param: stringvalidator.TimestampParams{
+				Layouts: []string{"2006-01-02 15:04 -0700"},
+			},
+			expectError: true,
+		},
+		"invalid-layout-without-offset": {
+			val: types.StringValue("2026-01-02 15:04"),
+			param: stringvalidator.TimestampParams{
+				Layouts: []string{"2006-01-02 15:04"},
+			},
+			expectError: true,
+		},
+		"invalid-negative-max-ahead": {
+			val: types.StringValue("2026-03-02T12:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				Clock:    clock,
+				MaxAhead: -time.Hour,
+			},
+			expectError: true,
+		},
+		"invalid-past": {
+			val: types.StringValue("2026-03-01T11:59:59Z"),
+			param: stringvalidator.TimestampParams{
+				Clock:  clock,
+				Future: true,
+			},
+			expectError: true,
+		},
+		"invalid-past-offset": {
+			// 12:30+01:00 is 11:30 UTC.
+			val: types.StringValue("2026-03-01T12:30:00+01:00"),
+			param: stringvalidator.TimestampParams{
+				Clock:  clock,
+				Future: true,
+			},
+			expectError: true,
+		},
+		"invalid-max-ahead": {
+			val: types.StringValue("2026-03-31T12:00:01Z"),
+			param: stringvalidator.TimestampParams{
+				Clock:    clock,
+				MaxAhead: 30 * 24 * time.Hour,
+			},
+			expectError: true,
+		},
+		"invalid-before": {
+			val: types.StringValue("2026-03-01T22:00:00Z"),
+			param: stringvalidator.TimestampParams{
+				AfterPath: path.MatchRoot("start_time"),
+			},
+			startTime:   tftypes.NewValue(tftypes.String, "2026-03-01T23:00:00Z"),
+			expectError: true,
+		},
+		"invalid-equal": {
+			val: types.StringValue("2026-03-02T00:00:00+01:00"),
+			param: stringvalidator.TimestampParams{
+				AfterPath: path.MatchRoot("start_time"),
+			},
+			startTime:   tftypes.NewValue(tftypes.String, "2026-03-01T23:00:00Z"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			startTime := test.startTime
+			if startTime.Type() == nil {
+				startTime = tftypes.NewValue(tftypes.String, nil)
+			}
+
+			request := validator.StringRequest{
+				Path:           path.Root("end_time"),
+				PathExpression: path.MatchRoot("end_time"),
+				ConfigValue:    test.val,
+				Config:         config(startTime),
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Timestamp(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidTimestampValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Timestamp(stringvalidator.TimestampParams{
+		Future:    true,
+		MaxAhead:  30 * 24 * time.Hour,
+		AfterPath: path.MatchRoot("start_time"),
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be a RFC 3339 timestamp with a timezone offset (Ex: 2026-01-02T15:04:05Z), in the future, no further than 30 days ahead, after the timestamp of the start_time attribute"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be a RFC 3339 timestamp with a timezone offset (Ex: `2026-01-02T15:04:05Z`), in the future, no further than 30 days ahead, after the timestamp of the [`start_time`](#start_time) attribute"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
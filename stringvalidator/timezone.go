@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = timezone{}
+
+type TimezoneParams struct {
+	// DenyEtc rejects the Etc/* zones and their aliases (Ex: Etc/GMT+2, UTC, GMT).
+	DenyEtc bool
+	// DenyLegacyAliases rejects the legacy aliases (Ex: US/Eastern, Asia/Calcutta, CET).
+	DenyLegacyAliases bool
+	// Regions is the list of allowed ISO 3166-1 alpha-2 country codes (Ex: FR, DE).
+	// If set, the zone must be used in one of the countries, so the Etc/* zones are rejected.
+	// The legacy aliases inherit the countries of the zone they link to.
+	Regions []string
+}
+
+func (p TimezoneParams) description(format string) string {
+	description := fmt.Sprintf("an IANA timezone (Ex: %s)", fmt.Sprintf(format, "Europe/Paris"))
+
+	var constraints []string
+	if p.DenyEtc {
+		constraints = append(constraints, fmt.Sprintf("not an %s zone", fmt.Sprintf(format, "Etc/*")))
+	}
+	if p.DenyLegacyAliases {
+		constraints = append(constraints, "not a legacy alias")
+	}
+	if len(p.Regions) > 0 {
+		regions := make([]string, 0, len(p.Regions))
+		for _, region := range p.Regions {
+			regions = append(regions, fmt.Sprintf(format, region))
+		}
+		constraints = append(constraints, fmt.Sprintf("used in one of the countries %s", strings.Join(regions, ", ")))
+	}
+
+	if len(constraints) > 0 {
+		description += ", " + strings.Join(constraints, ", ")
+	}
+
+	return description
+}
+
+type timezone struct {
+	params TimezoneParams
+}
+
+// Description describes the validation in plain text formatting.
+func (validator timezone) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator timezone) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator timezone) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	tz, _, err := internal.LoadTimezone(value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timezone",
+			err.Error(),
+		)
+		return
+	}
+
+	switch {
+	case tz.Kind == internal.TimezoneKindEtc && validator.params.DenyEtc:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timezone",
+			fmt.Sprintf("The timezone %q is an Etc/* zone, use a geographical zone instead (Ex: Europe/Paris)", value),
+		)
+		return
+	case tz.Kind == internal.TimezoneKindAlias && validator.params.DenyLegacyAliases:
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid timezone",
+			fmt.Sprintf("The timezone %q is a legacy alias, use the canonical zone instead", value),
+		)
+		return
+	}
+
+	if len(validator.params.Regions) == 0 {
+		return
+	}
+
+	for _, region := range validator.params.Regions {
+		for _, country := range tz.Countries {
+			if strings.EqualFold(region, country) {
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid timezone",
+		fmt.Sprintf("The timezone %q is not used in one of the countries %s", value, strings.Join(validator.params.Regions, ", ")),
+	)
+}
+
+// Timezone validates that a string is an IANA timezone name (Ex: Europe/Paris).
+//
+// The names are resolved with the timezone database embedded in the provider (time/tzdata),
+// so they are valid even on a machine without timezone files.
+// The kind (zone, Etc/* or legacy alias) and the countries come from the list bundled with the module.
+//
+// Parameters:
+//   - settings: TimezoneParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string is a valid timezone.
+func Timezone(settings TimezoneParams) validator.String {
+	return &timezone{
+		params: settings,
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidTimezoneValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		param       stringvalidator.TimezoneParams
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: types.StringUnknown(),
+		},
+		"null": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue("Europe/Paris"),
+		},
+		"valid-three-levels": {
+			val: types.StringValue("America/Argentina/Buenos_Aires"),
+		},
+		"valid-etc": {
+			val: types.StringValue("Etc/GMT+2"),
+		},
+		"valid-utc": {
+			val: types.StringValue("UTC"),
+		},
+		"valid-legacy-alias": {
+			val: types.StringValue("US/Eastern"),
+		},
+		"valid-legacy-alias-allowed-with-etc-denied": {
+			val:   types.StringValue("Asia/Calcutta"),
+			param: stringvalidator.TimezoneParams{DenyEtc: true},
+		},
+		"valid-region": {
+			val:   types.StringValue("Europe/Paris"),
+			param: stringvalidator.TimezoneParams{Regions: []string{"fr", "DE"}},
+		},
+		"valid-region-multiple-countries": {
+			// Europe/Berlin is also used in Norway and Sweden.
+			val:   types.StringValue("Europe/Berlin"),
+			param: stringvalidator.TimezoneParams{Regions: []string{"SE"}},
+		},
+		"valid-region-alias": {
+			val:   types.StringValue("US/Pacific"),
+			param: stringvalidator.TimezoneParams{Regions: []string{"US"}},
+		},
+		"invalid-empty": {
+			val:         types.StringValue(""),
+			expectError: true,
+		},
+		"invalid-unknown": {
+			val:         types.StringValue("Europe/Atlantis"),
+			expectError: true,
+		},
+		"invalid-case": {
+			val:         types.StringValue("europe/paris"),
+			expectError: true,
+		},
+		"invalid-local": {
+			val:         types.StringValue("Local"),
+			expectError: true,
+		},
+		"invalid-path": {
+			val:         types.StringValue("../zoneinfo/Europe/Paris"),
+			expectError: true,
+		},
+		"invalid-abbreviation": {
+			val:         types.StringValue("CEST"),
+			expectError: true,
+		},
+		"invalid-etc-denied": {
+			val:         types.StringValue("Etc/GMT+2"),
+			param:       stringvalidator.TimezoneParams{DenyEtc: true},
+			expectError: true,
+		},
+		"invalid-utc-denied": {
+			val:         types.StringValue("UTC"),
+			param:       stringvalidator.TimezoneParams{DenyEtc: true},
+			expectError: true,
+		},
+		"invalid-legacy-alias-denied": {
+			val:         types.StringValue("Asia/Calcutta"),
+			param:       stringvalidator.TimezoneParams{DenyLegacyAliases: true},
+			expectError: true,
+		},
+		"invalid-region": {
+			val:         types.StringValue("America/New_York"),
+			param:       stringvalidator.TimezoneParams{Regions: []string{"FR"}},
+			expectError: true,
+		},
+		"invalid-region-etc": {
+			val:         types.StringValue("Etc/UTC"),
+			param:       stringvalidator.TimezoneParams{Regions: []string{"FR"}},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.Timezone(test.param).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidTimezoneValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.Timezone(stringvalidator.TimezoneParams{
+		DenyEtc:           true,
+		DenyLegacyAliases: true,
+		Regions:           []string{"FR", "DE"},
+	})
+
+	ctx := context.Background()
+	if got, want := v.Description(ctx), "The value must be an IANA timezone (Ex: Europe/Paris), not an Etc/* zone, not a legacy alias, used in one of the countries FR, DE"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(ctx), "The value must be an IANA timezone (Ex: `Europe/Paris`), not an `Etc/*` zone, not a legacy alias, used in one of the countries `FR`, `DE`"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
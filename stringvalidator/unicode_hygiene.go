@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/internal"
+)
+
+var _ validator.String = unicodeHygiene{}
+
+type UnicodeNormalization string
+
+const (
+	// UnicodeNormalizationNone does not check the normalization form.
+	UnicodeNormalizationNone UnicodeNormalization = ""
+	// UnicodeNormalizationNFC requires the canonical composition (Ex: é as U+00E9 instead of e and U+0301).
+	UnicodeNormalizationNFC UnicodeNormalization = "nfc"
+	// UnicodeNormalizationNFKC requires the compatibility composition (Ex: no fullwidth letters, no ligatures).
+	UnicodeNormalizationNFKC UnicodeNormalization = "nfkc"
+)
+
+type UnicodeHygieneParams struct {
+	// ASCIIOnly rejects the characters outside of the ASCII range.
+	ASCIIOnly bool
+	// PrintableOnly rejects the control characters (Ex: newline, tab), the format characters (Ex: zero-width space, BOM)
+	// and the spaces other than the ASCII space (Ex: no-break space).
+	PrintableOnly bool
+	// Normalization is the required normalization form. Default is UnicodeNormalizationNone.
+	Normalization UnicodeNormalization
+	// DenyLeadingTrailingWhitespace rejects the whitespaces at the start and at the end of the value (Ex: the trailing newline of a heredoc).
+	DenyLeadingTrailingWhitespace bool
+	// DenyConfusables rejects the homoglyphs of the ASCII letters and digits: the compatibility variants (Ex: the fullwidth Ａ)
+	// and the letters of the other scripts mixed with Latin letters (Ex: the Cyrillic а in pаypal).
+	DenyConfusables bool
+}
+
+func (p UnicodeHygieneParams) description(format string) string {
+	var constraints []string
+	if p.ASCIIOnly {
+		constraints = append(constraints, "only ASCII characters")
+	}
+	if p.PrintableOnly {
+		constraints = append(constraints, "only printable characters")
+	}
+	if p.Normalization != UnicodeNormalizationNone {
+		constraints = append(constraints, fmt.Sprintf("in the Unicode normalization form %s", fmt.Sprintf(format, strings.ToUpper(string(p.Normalization)))))
+	}
+	if p.DenyLeadingTrailingWhitespace {
+		constraints = append(constraints, "without leading or trailing whitespace")
+	}
+	if p.DenyConfusables {
+		constraints = append(constraints, "without homoglyphs of the ASCII letters and digits")
+	}
+
+	if len(constraints) == 0 {
+		return "a string"
+	}
+
+	return "a string with " + strings.Join(constraints, ", ")
+}
+
+// unicodeHygieneRune formats a rune and its offset for the diagnostics.
+func unicodeHygieneRune(r rune, offset int) string {
+	return fmt.Sprintf("the character %q (%U) at byte offset %d", r, r, offset)
+}
+
+// unicodeNormalizationForms maps the normalization forms to their implementation.
+var unicodeNormalizationForms = map[UnicodeNormalization]norm.Form{
+	UnicodeNormalizationNFC:  norm.NFC,
+	UnicodeNormalizationNFKC: norm.NFKC,
+}
+
+func (p UnicodeHygieneParams) validateConfig() error {
+	if _, ok := unicodeNormalizationForms[p.Normalization]; !ok && p.Normalization != UnicodeNormalizationNone {
+		return fmt.Errorf("unknown normalization form %q (expected nfc or nfkc)", p.Normalization)
+	}
+
+	return nil
+}
+
+// validate returns an error describing the first offending rune.
+func (p UnicodeHygieneParams) validate(value string) error {
+	if !utf8.ValidString(value) {
+		return fmt.Errorf("the value is not a valid UTF-8 string")
+	}
+
+	if p.DenyLeadingTrailingWhitespace && value != "" {
+		if r, _ := utf8.DecodeRuneInString(value); unicode.IsSpace(r) {
+			return fmt.Errorf("%s is a leading whitespace", unicodeHygieneRune(r, 0))
+		}
+	}
+
+	// The letters of the other scripts are homoglyphs only if they are mixed with Latin letters (Ex: pаypal with a Cyrillic а).
+	latin := strings.ContainsFunc(value, func(r rune) bool { return unicode.Is(unicode.Latin, r) })
+
+	for offset, r := range value {
+		switch {
+		case p.ASCIIOnly && r > unicode.MaxASCII:
+			return fmt.Errorf("%s is not an ASCII character", unicodeHygieneRune(r, offset))
+		case p.PrintableOnly && !unicode.IsPrint(r):
+			return fmt.Errorf("%s is not a printable character", unicodeHygieneRune(r, offset))
+		case p.DenyConfusables:
+			if ascii, ok := internal.Confusable(r, latin); ok {
+				return fmt.Errorf("%s is a homoglyph of %q", unicodeHygieneRune(r, offset), ascii)
+			}
+		}
+	}
+
+	if p.DenyLeadingTrailingWhitespace && value != "" {
+		if r, size := utf8.DecodeLastRuneInString(value); unicode.IsSpace(r) {
+			return fmt.Errorf("%s is a trailing whitespace", unicodeHygieneRune(r, len(value)-size))
+		}
+	}
+
+	if form, ok := unicodeNormalizationForms[p.Normalization]; ok && !form.IsNormalString(value) {
+		// The value is normalized up to the returned offset, the sequence starting there is not.
+		offset := form.QuickSpanString(value)
+		r, _ := utf8.DecodeRuneInString(value[offset:])
+		return fmt.Errorf("the sequence starting with %s is not in the normalization form %s (normalized value: %q)",
+			unicodeHygieneRune(r, offset), strings.ToUpper(string(p.Normalization)), form.String(value))
+	}
+
+	return nil
+}
+
+type unicodeHygiene struct {
+	params UnicodeHygieneParams
+	err    error
+}
+
+// Description describes the validation in plain text formatting.
+func (validator unicodeHygiene) Description(_ context.Context) string {
+	return "The value must be " + validator.params.description("%s")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator unicodeHygiene) MarkdownDescription(_ context.Context) string {
+	return "The value must be " + validator.params.description("`%s`")
+}
+
+// Validate performs the validation.
+func (validator unicodeHygiene) ValidateString(
+	_ context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if validator.err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid validator configuration",
+			validator.err.Error(),
+		)
+		return
+	}
+
+	if err := validator.params.validate(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid character",
+			err.Error(),
+		)
+	}
+}
+
+// UnicodeHygiene validates that a string does not contain invisible or misleading characters
+// (Ex: zero-width spaces, no-break spaces, trailing newlines, decomposed accents, homoglyphs).
+//
+// The diagnostic reports the code point and the byte offset of the first offending character.
+//
+// Parameters:
+//   - settings: UnicodeHygieneParams containing the configuration for the validator.
+//
+// Returns:
+//   - validator.String: A validator that checks if the string contains only the allowed characters.
+func UnicodeHygiene(settings UnicodeHygieneParams) validator.String {
+	return &unicodeHygiene{
+		params: settings,
+		err:    settings.validateConfig(),
+	}
+}
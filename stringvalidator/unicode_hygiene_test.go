@@ -0,0 +1,196 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package stringvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-validators/stringvalidator"
+)
+
+func TestValidUnicodeHygieneValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		params      stringvalidator.UnicodeHygieneParams
+		expectError bool
+		wantDetail  string
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val:    types.StringUnknown(),
+			params: stringvalidator.UnicodeHygieneParams{ASCIIOnly: true},
+		},
+		"null": {
+			val:    types.StringNull(),
+			params: stringvalidator.UnicodeHygieneParams{ASCIIOnly: true},
+		},
+		"no-option": {
+			val: types.StringValue(" ｖａｌｕｅ​\n"),
+		},
+		"ascii-only": {
+			val:    types.StringValue("my value 2"),
+			params: stringvalidator.UnicodeHygieneParams{ASCIIOnly: true},
+		},
+		"ascii-only-invalid": {
+			val:         types.StringValue("café"),
+			params:      stringvalidator.UnicodeHygieneParams{ASCIIOnly: true},
+			expectError: true,
+			wantDetail:  `the character 'é' (U+00E9) at byte offset 3 is not an ASCII character`,
+		},
+		"printable-only": {
+			val:    types.StringValue("café crème"),
+			params: stringvalidator.UnicodeHygieneParams{PrintableOnly: true},
+		},
+		"printable-only-zero-width-space": {
+			val:         types.StringValue("my​value"),
+			params:      stringvalidator.UnicodeHygieneParams{PrintableOnly: true},
+			expectError: true,
+			wantDetail:  `the character '\u200b' (U+200B) at byte offset 2 is not a printable character`,
+		},
+		"printable-only-no-break-space": {
+			val:         types.StringValue("my value"),
+			params:      stringvalidator.UnicodeHygieneParams{PrintableOnly: true},
+			expectError: true,
+		},
+		"printable-only-newline": {
+			val:         types.StringValue("value\n"),
+			params:      stringvalidator.UnicodeHygieneParams{PrintableOnly: true},
+			expectError: true,
+		},
+		"nfc": {
+			val:    types.StringValue("café"),
+			params: stringvalidator.UnicodeHygieneParams{Normalization: stringvalidator.UnicodeNormalizationNFC},
+		},
+		"nfc-invalid-decomposed": {
+			val:         types.StringValue("café"),
+			params:      stringvalidator.UnicodeHygieneParams{Normalization: stringvalidator.UnicodeNormalizationNFC},
+			expectError: true,
+		},
+		"nfc-fullwidth": {
+			val:    types.StringValue("ｖａｌｕｅ"),
+			params: stringvalidator.UnicodeHygieneParams{Normalization: stringvalidator.UnicodeNormalizationNFC},
+		},
+		"nfkc-invalid-fullwidth": {
+			val:         types.StringValue("ｖａｌｕｅ"),
+			params:      stringvalidator.UnicodeHygieneParams{Normalization: stringvalidator.UnicodeNormalizationNFKC},
+			expectError: true,
+		},
+		"invalid-normalization": {
+			val:         types.StringValue("value"),
+			params:      stringvalidator.UnicodeHygieneParams{Normalization: "nfd"},
+			expectError: true,
+		},
+		"trimmed": {
+			val:    types.StringValue("my value"),
+			params: stringvalidator.UnicodeHygieneParams{DenyLeadingTrailingWhitespace: true},
+		},
+		"trimmed-empty": {
+			val:    types.StringValue(""),
+			params: stringvalidator.UnicodeHygieneParams{DenyLeadingTrailingWhitespace: true},
+		},
+		"trimmed-invalid-leading": {
+			val:         types.StringValue(" value"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyLeadingTrailingWhitespace: true},
+			expectError: true,
+			wantDetail:  `the character ' ' (U+0020) at byte offset 0 is a leading whitespace`,
+		},
+		"trimmed-invalid-trailing-newline": {
+			val:         types.StringValue("value\n"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyLeadingTrailingWhitespace: true},
+			expectError: true,
+			wantDetail:  `the character '\n' (U+000A) at byte offset 5 is a trailing whitespace`,
+		},
+		"trimmed-invalid-trailing-no-break-space": {
+			val:         types.StringValue("value "),
+			params:      stringvalidator.UnicodeHygieneParams{DenyLeadingTrailingWhitespace: true},
+			expectError: true,
+		},
+		"confusables": {
+			val:    types.StringValue("ελληνικά 2026"),
+			params: stringvalidator.UnicodeHygieneParams{DenyConfusables: true},
+		},
+		"confusables-invalid-cyrillic": {
+			val:         types.StringValue("pаypal"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyConfusables: true},
+			expectError: true,
+			wantDetail:  `the character 'а' (U+0430) at byte offset 1 is a homoglyph of 'a'`,
+		},
+		"confusables-greek-with-latin-acronym": {
+			val:         types.StringValue("ελληνικά ABC"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyConfusables: true},
+			expectError: true,
+		},
+		"confusables-invalid-fullwidth": {
+			val:         types.StringValue("ａdmin"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyConfusables: true},
+			expectError: true,
+		},
+		"confusables-invalid-mathematical": {
+			val:         types.StringValue("\U0001d400dmin"),
+			params:      stringvalidator.UnicodeHygieneParams{DenyConfusables: true},
+			expectError: true,
+		},
+		"first-offending-rune": {
+			val: types.StringValue("ab cа"),
+			params: stringvalidator.UnicodeHygieneParams{
+				PrintableOnly:   true,
+				DenyConfusables: true,
+			},
+			expectError: true,
+			wantDetail:  `the character '\u00a0' (U+00A0) at byte offset 2 is not a printable character`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := validator.StringRequest{
+				ConfigValue: test.val,
+			}
+			response := validator.StringResponse{}
+			stringvalidator.UnicodeHygiene(test.params).ValidateString(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+
+			if test.wantDetail != "" && response.Diagnostics[0].Detail() != test.wantDetail {
+				t.Fatalf("expected detail %q, got %q", test.wantDetail, response.Diagnostics[0].Detail())
+			}
+		})
+	}
+}
+
+func TestUnicodeHygieneValidatorDescription(t *testing.T) {
+	t.Parallel()
+
+	v := stringvalidator.UnicodeHygiene(stringvalidator.UnicodeHygieneParams{
+		PrintableOnly:                 true,
+		Normalization:                 stringvalidator.UnicodeNormalizationNFC,
+		DenyLeadingTrailingWhitespace: true,
+	})
+
+	if got, want := v.Description(context.Background()), "The value must be a string with only printable characters, in the Unicode normalization form NFC, without leading or trailing whitespace"; got != want {
+		t.Errorf("expected description %q, got %q", want, got)
+	}
+	if got, want := v.MarkdownDescription(context.Background()), "The value must be a string with only printable characters, in the Unicode normalization form `NFC`, without leading or trailing whitespace"; got != want {
+		t.Errorf("expected markdown description %q, got %q", want, got)
+	}
+}
@@ -35,10 +35,10 @@ Deprecated: This function is deprecated and will be removed in a future version.
 Use `FormatsIsURN` instead.
 */
 func IsURN() validator.String {
-	return &common.RegexValidator{
+	return common.MustNewRegexValidator(common.RegexValidator{
 		Desc:         "must be a valid URN",
-		Regex:        `(?m)urn:[A-Za-z0-9][A-Za-z0-9-]{0,31}:([A-Za-z0-9()+,\-.:=@;$_!*']|%[0-9A-Fa-f]{2})+`,
+		Regex:        `^urn:[A-Za-z0-9][A-Za-z0-9-]{0,31}:([A-Za-z0-9()+,\-.:=@;$_!*']|%[0-9A-Fa-f]{2})+$`,
 		ErrorSummary: "Failed to parse URN",
 		ErrorDetail:  "This value is not a valid URN",
-	}
+	})
 }
@@ -49,6 +49,14 @@ func TestValidURNValidator(t *testing.T) {
 			val:         types.StringValue("4aeb40d8-038c-4e77-8181-a7054f583b12"),
 			expectError: true,
 		},
+		"invalid-embedded": {
+			val:         types.StringValue("id=urn:test:demo:4aeb40d8-038c-4e77-8181-a7054f583b12"),
+			expectError: true,
+		},
+		"invalid-trailing-line": {
+			val:         types.StringValue("urn:test:demo:4aeb40d8\nnot a urn"),
+			expectError: true,
+		},
 		"multiple byte characters": {
 			// Rightwards Arrow Over Leftwards Arrow (U+21C4; 3 bytes)
 			val:         types.StringValue("⇄"),
@@ -35,10 +35,10 @@ Deprecated: This function is deprecated and will be removed in a future version.
 Use `FormatsIsUUIDv4` instead.
 */
 func IsUUID() validator.String {
-	return &common.RegexValidator{
+	return common.MustNewRegexValidator(common.RegexValidator{
 		Desc:         "must be a valid UUID",
 		Regex:        `(?m)^\w{8}-\w{4}-\w{4}-\w{4}-\w{12}$`,
 		ErrorSummary: "Failed to parse UUID",
 		ErrorDetail:  "This value is not a valid (v4) UUID",
-	}
+	})
 }